@@ -0,0 +1,133 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// UnmarshalStrings decodes a JSON array into a slice of Strings, element by element.
+// Elements are decoded like String.UnmarshalJSON, except that numbers and booleans
+// are kept as their JSON text, so [1, "a", true] decodes to "1", "a", and "true".
+// null and blank elements produce null Strings, and null input produces a nil slice.
+func UnmarshalStrings(data []byte) ([]String, error) {
+	elems, err := decodeArray(data, "null.String")
+	if elems == nil {
+		return nil, err
+	}
+	out := make([]String, len(elems))
+	for i, elem := range elems {
+		switch kindOf(elem) {
+		case jsonNumber:
+			out[i] = StringFrom(string(elem))
+			continue
+		case jsonOther:
+			if string(elem) == "true" || string(elem) == "false" {
+				out[i] = StringFrom(string(elem))
+				continue
+			}
+		}
+		if err := out[i].UnmarshalJSON(elem); err != nil {
+			return nil, elementError(i, err)
+		}
+	}
+	return out, nil
+}
+
+// UnmarshalInts decodes a JSON array into a slice of Ints, element by element.
+// Elements are decoded like Int.UnmarshalJSON, except that string elements are parsed
+// with Int.UnmarshalText after trimming surrounding whitespace, so " 2 " and "+2" are accepted too.
+// null and blank string elements produce null Ints, and null input produces a nil slice.
+func UnmarshalInts(data []byte) ([]Int, error) {
+	elems, err := decodeArray(data, "null.Int")
+	if elems == nil {
+		return nil, err
+	}
+	out := make([]Int, len(elems))
+	for i, elem := range elems {
+		if err := decodeLenient(elem, "null.Int", out[i].UnmarshalJSON, out[i].UnmarshalText); err != nil {
+			return nil, elementError(i, err)
+		}
+	}
+	return out, nil
+}
+
+// UnmarshalFloats decodes a JSON array into a slice of Floats, element by element.
+// Elements are decoded like Float.UnmarshalJSON, except that string elements are parsed
+// with Float.UnmarshalText after trimming surrounding whitespace, so " 2.5 " and "+2.5" are accepted too.
+// null and blank string elements produce null Floats, and null input produces a nil slice.
+func UnmarshalFloats(data []byte) ([]Float, error) {
+	elems, err := decodeArray(data, "null.Float")
+	if elems == nil {
+		return nil, err
+	}
+	out := make([]Float, len(elems))
+	for i, elem := range elems {
+		if err := decodeLenient(elem, "null.Float", out[i].UnmarshalJSON, out[i].UnmarshalText); err != nil {
+			return nil, elementError(i, err)
+		}
+	}
+	return out, nil
+}
+
+// UnmarshalBools decodes a JSON array into a slice of Bools, element by element.
+// Besides the input Bool.UnmarshalJSON supports, the string elements "true" and "false"
+// are parsed with Bool.UnmarshalText after trimming surrounding whitespace.
+// null and blank string elements produce null Bools, and null input produces a nil slice.
+func UnmarshalBools(data []byte) ([]Bool, error) {
+	elems, err := decodeArray(data, "null.Bool")
+	if elems == nil {
+		return nil, err
+	}
+	out := make([]Bool, len(elems))
+	for i, elem := range elems {
+		if err := decodeLenient(elem, "null.Bool", out[i].UnmarshalJSON, out[i].UnmarshalText); err != nil {
+			return nil, elementError(i, err)
+		}
+	}
+	return out, nil
+}
+
+// UnmarshalTimes decodes a JSON array into a slice of Times, element by element,
+// like Time.UnmarshalJSON, which already accepts both timestamp strings and Unix timestamp numbers.
+// null and blank elements produce null Times, and null input produces a nil slice.
+func UnmarshalTimes(data []byte) ([]Time, error) {
+	elems, err := decodeArray(data, "null.Time")
+	if elems == nil {
+		return nil, err
+	}
+	out := make([]Time, len(elems))
+	for i, elem := range elems {
+		if err := out[i].UnmarshalJSON(elem); err != nil {
+			return nil, elementError(i, err)
+		}
+	}
+	return out, nil
+}
+
+// decodeArray splits the JSON array data into its elements, which encoding/json gives without surrounding whitespace.
+// It returns nil and no error for null input, and nil and an UnmarshalError for anything but an array.
+func decodeArray(data []byte, typ string) ([]json.RawMessage, error) {
+	var elems []json.RawMessage
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return nil, unmarshalError(data, "[]"+typ, err)
+	}
+	return elems, nil
+}
+
+// decodeLenient decodes elem with unmarshalText if it is a JSON string, and with unmarshalJSON otherwise.
+func decodeLenient(elem []byte, typ string, unmarshalJSON, unmarshalText func([]byte) error) error {
+	if kindOf(elem) != jsonString {
+		return unmarshalJSON(elem)
+	}
+	s, err := unquoteJSON(elem)
+	if err == nil {
+		err = unmarshalText([]byte(strings.TrimSpace(s)))
+	}
+	return unmarshalError(elem, typ, err)
+}
+
+// elementError reports which element of an array failed to decode.
+func elementError(i int, err error) error {
+	return fmt.Errorf("null: cannot decode array element %d: %w", i, err)
+}
@@ -0,0 +1,100 @@
+package null
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUnmarshalInts(t *testing.T) {
+	ints, err := UnmarshalInts([]byte(`[1, "2", null, 3, " 4 ", "", {"Int64":5,"Valid":true}]`))
+	maybePanic(err)
+	want := []Int{IntFrom(1), IntFrom(2), {}, IntFrom(3), IntFrom(4), {}, IntFrom(5)}
+	if len(ints) != len(want) {
+		t.Fatalf("UnmarshalInts() returned %d elements, want %d", len(ints), len(want))
+	}
+	for i := range want {
+		if !ints[i].Equal(want[i]) {
+			t.Errorf("element %d = %v, want %v", i, ints[i], want[i])
+		}
+	}
+
+	_, err = UnmarshalInts([]byte(`[1, "2.5"]`))
+	var ue *UnmarshalError
+	if !errors.As(err, &ue) || ue.Type != "null.Int" || ue.Value != `"2.5"` {
+		t.Errorf("bad element error: %v", err)
+	}
+	if _, err := UnmarshalInts([]byte(`[true]`)); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := UnmarshalInts([]byte(`{"a":1}`)); !errors.As(err, &ue) || ue.Type != "[]null.Int" {
+		t.Errorf("bad non-array error: %v", err)
+	}
+
+	null, err := UnmarshalInts(nullJSON)
+	maybePanic(err)
+	if null != nil {
+		t.Errorf("null input should give a nil slice, got %v", null)
+	}
+	empty, err := UnmarshalInts([]byte(`[]`))
+	maybePanic(err)
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("empty array should give an empty slice, got %#v", empty)
+	}
+}
+
+func TestUnmarshalFloats(t *testing.T) {
+	floats, err := UnmarshalFloats([]byte(`[1, "2.5", null, 3e2, "-0.5"]`))
+	maybePanic(err)
+	want := []Float{FloatFrom(1), FloatFrom(2.5), {}, FloatFrom(300), FloatFrom(-0.5)}
+	for i := range want {
+		if !floats[i].Equal(want[i]) {
+			t.Errorf("element %d = %v, want %v", i, floats[i], want[i])
+		}
+	}
+	if _, err := UnmarshalFloats([]byte(`[1, "x"]`)); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestUnmarshalBools(t *testing.T) {
+	bools, err := UnmarshalBools([]byte(`[true, "false", null, 1, "true"]`))
+	maybePanic(err)
+	want := []Bool{BoolFrom(true), BoolFrom(false), {}, BoolFrom(true), BoolFrom(true)}
+	for i := range want {
+		if !bools[i].Equal(want[i]) {
+			t.Errorf("element %d = %v, want %v", i, bools[i], want[i])
+		}
+	}
+	if _, err := UnmarshalBools([]byte(`["maybe"]`)); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestUnmarshalStrings(t *testing.T) {
+	strs, err := UnmarshalStrings([]byte(`[1, "2", null, 3.5, true, ""]`))
+	maybePanic(err)
+	want := []String{StringFrom("1"), StringFrom("2"), {}, StringFrom("3.5"), StringFrom("true"), {}}
+	for i := range want {
+		if !strs[i].Equal(want[i]) {
+			t.Errorf("element %d = %v, want %v", i, strs[i], want[i])
+		}
+	}
+	if _, err := UnmarshalStrings([]byte(`[[]]`)); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestUnmarshalTimes(t *testing.T) {
+	times, err := UnmarshalTimes([]byte(`["2012-12-21T21:21:21Z", null, 1356124881]`))
+	maybePanic(err)
+	want := []Time{TimeFrom(timeValue), {}, TimeFrom(time.Unix(1356124881, 0))}
+	for i := range want {
+		if !times[i].Equal(want[i]) {
+			t.Errorf("element %d = %v, want %v", i, times[i], want[i])
+		}
+	}
+	if _, err := UnmarshalTimes([]byte(`["2012-12-21T21:21:21Z", "x"]`)); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,184 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+// BigInt is a nullable *big.Int, for arbitrary-precision integers.
+// It will marshal to null if null, and to a decimal string otherwise,
+// so that values are not truncated by JSON decoders that use float64.
+// It is stored in SQL as a decimal string.
+type BigInt struct {
+	BigInt *big.Int
+	Valid  bool
+}
+
+// NewBigInt creates a new BigInt
+func NewBigInt(b *big.Int, valid bool) BigInt {
+	return BigInt{
+		BigInt: b,
+		Valid:  valid,
+	}
+}
+
+// BigIntFrom creates a new BigInt that will be valid unless b is nil.
+// Since the value is already a pointer, there is no separate BigIntFromPtr.
+func BigIntFrom(b *big.Int) BigInt {
+	return NewBigInt(b, b != nil)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports decimal string, number, and null input.
+// Blank string input produces a null BigInt.
+func (b *BigInt) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.BigInt")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		return b.UnmarshalText([]byte(x))
+	case float64:
+		// Unmarshal again, to json.Number, to avoid losing precision to float64
+		var n json.Number
+		if err = json.Unmarshal(data, &n); err == nil {
+			err = b.UnmarshalText([]byte(n))
+		}
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.BigInt", reflect.TypeOf(v).Name())
+	}
+	b.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null BigInt if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not a base 10 integer.
+func (b *BigInt) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		b.Valid = false
+		return nil
+	}
+	n, ok := new(big.Int).SetString(str, 10)
+	if !ok {
+		b.Valid = false
+		return fmt.Errorf("null: invalid BigInt: %q", str)
+	}
+	b.BigInt, b.Valid = n, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this BigInt is null.
+func (b BigInt) MarshalJSON() ([]byte, error) {
+	if !b.Valid || b.BigInt == nil {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + b.BigInt.String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this BigInt is null.
+func (b BigInt) MarshalText() ([]byte, error) {
+	if !b.Valid || b.BigInt == nil {
+		return nullText(), nil
+	}
+	return []byte(b.BigInt.String()), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports int64 values as well as decimal strings and []byte.
+func (b *BigInt) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case int64:
+		b.BigInt, b.Valid = big.NewInt(x), true
+		return nil
+	case []byte:
+		return b.UnmarshalText(x)
+	case string:
+		return b.UnmarshalText([]byte(x))
+	case nil:
+		b.Valid = false
+		return nil
+	}
+	b.Valid = false
+	return fmt.Errorf("null: cannot scan type %T into null.BigInt: %v", value, value)
+}
+
+// Value implements driver.Valuer.
+// It returns this BigInt as a decimal string, or nil if this BigInt is null.
+func (b BigInt) Value() (driver.Value, error) {
+	if !b.Valid || b.BigInt == nil {
+		return nil, nil
+	}
+	return b.BigInt.String(), nil
+}
+
+// SetValid changes this BigInt's value and also sets it to be non-null.
+func (b *BigInt) SetValid(n *big.Int) {
+	b.BigInt = n
+	b.Valid = n != nil
+}
+
+// SetNull sets this BigInt to null, resetting its value to zero.
+func (b *BigInt) SetNull() {
+	b.BigInt = nil
+	b.Valid = false
+}
+
+// ValueOrZero returns the inner value if valid, otherwise a new zero *big.Int.
+func (b BigInt) ValueOrZero() *big.Int {
+	if !b.Valid || b.BigInt == nil {
+		return new(big.Int)
+	}
+	return b.BigInt
+}
+
+// Equal returns true if both BigInts have the same value or are both null.
+func (b BigInt) Equal(other BigInt) bool {
+	bv, ov := b.Valid && b.BigInt != nil, other.Valid && other.BigInt != nil
+	return bv == ov && (!bv || b.BigInt.Cmp(other.BigInt) == 0)
+}
+
+// Changed returns true if this BigInt differs from old: if one is null and the other isn't,
+// or if both are valid with different values. It is the inverse of Equal.
+func (b BigInt) Changed(old BigInt) bool {
+	return !b.Equal(old)
+}
+
+// String implements fmt.Stringer.
+// It returns this BigInt's value in base 10, or NullDisplay if null.
+func (b BigInt) String() string {
+	if !b.Valid || b.BigInt == nil {
+		return NullDisplay
+	}
+	return b.BigInt.String()
+}
+
+// IsNull returns true if this BigInt is null.
+func (b BigInt) IsNull() bool {
+	return !b.Valid
+}
+
+// Null is the same as IsNull.
+func (b BigInt) Null() bool {
+	return !b.Valid
+}
+
+// IsValid returns true if this BigInt is not null.
+func (b BigInt) IsValid() bool {
+	return b.Valid
+}
+
+// IsZero returns true for null BigInts and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null BigInt from a valid zero one.
+func (b BigInt) IsZero() bool {
+	return !b.Valid || b.BigInt == nil || b.BigInt.Sign() == 0
+}
@@ -0,0 +1,223 @@
+package null
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+var (
+	bigIntString     = "1234567890123456789012345678901234567890"
+	bigIntJSON       = []byte(`"` + bigIntString + `"`)
+	bigIntNumberJSON = []byte(bigIntString)
+)
+
+func bigIntValue() *big.Int {
+	n, _ := new(big.Int).SetString(bigIntString, 10)
+	return n
+}
+
+func TestBigIntFrom(t *testing.T) {
+	b := BigIntFrom(bigIntValue())
+	assertBigInt(t, b, "BigIntFrom()")
+
+	zero := BigIntFrom(new(big.Int))
+	if !zero.Valid {
+		t.Error("BigIntFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestBigIntFromNil(t *testing.T) {
+	null := BigIntFrom(nil)
+	assertNullBigInt(t, null, "BigIntFrom(nil)")
+}
+
+func TestUnmarshalBigInt(t *testing.T) {
+	var b BigInt
+	err := json.Unmarshal(bigIntJSON, &b)
+	maybePanic(err)
+	assertBigInt(t, b, "big int string json")
+
+	var num BigInt
+	err = json.Unmarshal(bigIntNumberJSON, &num)
+	maybePanic(err)
+	assertBigInt(t, num, "big int number json")
+
+	var neg BigInt
+	err = json.Unmarshal([]byte(`-`+bigIntString), &neg)
+	maybePanic(err)
+	if neg.BigInt.Cmp(new(big.Int).Neg(bigIntValue())) != 0 {
+		t.Errorf("bad negative big int: %v\n", neg.BigInt)
+	}
+
+	var blank BigInt
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullBigInt(t, blank, "blank string json")
+
+	var null BigInt
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullBigInt(t, null, "null json")
+
+	var invalid BigInt
+	err = json.Unmarshal(stringJSON, &invalid)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBigInt(t, invalid, "invalid string json")
+
+	var fraction BigInt
+	err = json.Unmarshal(floatJSON, &fraction)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBigInt(t, fraction, "float json")
+
+	var badType BigInt
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBigInt(t, badType, "wrong type json")
+}
+
+func TestMarshalBigInt(t *testing.T) {
+	b := BigIntFrom(bigIntValue())
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(bigIntJSON), "non-empty json marshal")
+
+	var out BigInt
+	err = json.Unmarshal(data, &out)
+	maybePanic(err)
+	assertBigInt(t, out, "round trip json")
+
+	data, err = b.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, bigIntString, "non-empty text marshal")
+
+	null := BigIntFrom(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestBigIntScan(t *testing.T) {
+	var b BigInt
+	err := b.Scan([]byte(bigIntString))
+	maybePanic(err)
+	assertBigInt(t, b, "scanned []byte")
+
+	var s BigInt
+	err = s.Scan(bigIntString)
+	maybePanic(err)
+	assertBigInt(t, s, "scanned string")
+
+	var i BigInt
+	err = i.Scan(int64(12345))
+	maybePanic(err)
+	if !i.Valid || i.BigInt.Int64() != 12345 {
+		t.Errorf("bad %s big int: %v\n", "scanned int64", i.BigInt)
+	}
+
+	var null BigInt
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullBigInt(t, null, "scanned null")
+
+	var wrong BigInt
+	err = wrong.Scan(true)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBigInt(t, wrong, "scanned bool")
+
+	v, err := s.Value()
+	maybePanic(err)
+	if v != bigIntString {
+		t.Errorf("bad big int value: %v ≠ %v\n", v, bigIntString)
+	}
+
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null big int value: %v ≠ nil\n", v)
+	}
+}
+
+func TestBigIntValueOrZero(t *testing.T) {
+	valid := BigIntFrom(bigIntValue())
+	if valid.ValueOrZero().Cmp(bigIntValue()) != 0 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewBigInt(bigIntValue(), false)
+	if invalid.ValueOrZero().Sign() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestBigIntEqual(t *testing.T) {
+	if !BigIntFrom(bigIntValue()).Equal(BigIntFrom(bigIntValue())) {
+		t.Error("Equal() of identical BigInts should return true")
+	}
+	if !BigIntFrom(nil).Equal(NewBigInt(bigIntValue(), false)) {
+		t.Error("Equal() of null BigInts should return true")
+	}
+	if BigIntFrom(bigIntValue()).Equal(BigIntFrom(big.NewInt(1))) {
+		t.Error("Equal() of different BigInts should return false")
+	}
+	if BigIntFrom(bigIntValue()).Equal(BigIntFrom(nil)) {
+		t.Error("Equal() of valid and null BigInts should return false")
+	}
+}
+
+func TestBigIntIsZero(t *testing.T) {
+	b := BigIntFrom(bigIntValue())
+	if b.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := BigIntFrom(nil)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := BigIntFrom(new(big.Int))
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestBigIntSetValid(t *testing.T) {
+	var change BigInt
+	assertNullBigInt(t, change, "SetValid()")
+	change.SetValid(bigIntValue())
+	assertBigInt(t, change, "SetValid()")
+}
+
+func assertBigInt(t *testing.T, b BigInt, from string) {
+	if b.BigInt == nil || b.BigInt.String() != bigIntString {
+		t.Errorf("bad %s big int: %v ≠ %v\n", from, b.BigInt, bigIntString)
+	}
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullBigInt(t *testing.T, b BigInt, from string) {
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 )
 
 // Bool is a nullable bool.
@@ -38,19 +40,54 @@ func BoolFromPtr(b *bool) Bool {
 	return NewBool(*b, true)
 }
 
+// BoolFromSQL creates a new Bool with the same value and validity as b.
+// The embedded NullBool field converts back the other way.
+func BoolFromSQL(b sql.NullBool) Bool {
+	return Bool{NullBool: b}
+}
+
+// BoolFromString creates a new Bool by parsing s with strconv.ParseBool.
+// It will be null if s is blank, and an error is returned if s is not a boolean.
+func BoolFromString(s string) (Bool, error) {
+	if s == "" {
+		return NewBool(false, false), nil
+	}
+	b, err := strconv.ParseBool(s)
+	return NewBool(b, err == nil), err
+}
+
+// MustBool is like BoolFromString but panics if s is not a boolean.
+// It is intended for test fixtures and package-level variables.
+func MustBool(s string) Bool {
+	b, err := BoolFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports number and null input.
-// 0 will not be considered a null Bool.
-// It also supports unmarshalling a sql.NullBool.
-func (b *Bool) UnmarshalJSON(data []byte) error {
-	var err error
+// It supports boolean and null input, and the numbers 0 and 1 for false and true.
+// Other numbers are an error. 0 and false will not be considered a null Bool.
+// It also supports unmarshalling a sql.NullBool, which is null unless its Valid field is true.
+func (b *Bool) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Bool")
 	var v interface{}
 	json.Unmarshal(data, &v)
 	switch x := v.(type) {
 	case bool:
 		b.Bool = x
+	case float64:
+		if x != 0 && x != 1 {
+			b.Valid = false
+			return fmt.Errorf("json: cannot unmarshal number %v into Go value of type null.Bool: only 0 and 1 are allowed", x)
+		}
+		b.Bool = x == 1
 	case map[string]interface{}:
+		b.NullBool = sql.NullBool{}
 		err = json.Unmarshal(data, &b.NullBool)
+		b.Valid = err == nil && b.Valid
+		return err
 	case nil:
 		b.Valid = false
 		return nil
@@ -61,11 +98,41 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 	return err
 }
 
+// UnmarshalJSONStrict is like UnmarshalJSON, but returns an error for input that
+// would produce a null Bool, such as null.
+// Call it from a custom UnmarshalJSON to reject missing values for required fields.
+func (b *Bool) UnmarshalJSONStrict(data []byte) error {
+	if err := b.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	if !b.Valid {
+		return strictNullError(data, "null.Bool")
+	}
+	return nil
+}
+
+// UnmarshalJSONValidate is like UnmarshalJSON, but also calls validate with the decoded value.
+// If validate returns an error, this Bool is set to null and the error is returned wrapped.
+// Input that produces a null Bool is not validated.
+func (b *Bool) UnmarshalJSONValidate(data []byte, validate func(bool) error) error {
+	if err := b.UnmarshalJSON(data); err != nil || !b.Valid {
+		return err
+	}
+	if err := validate(b.Bool); err != nil {
+		b.Valid = false
+		return validateError(data, "null.Bool", err)
+	}
+	return nil
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Bool if the input is a blank or not an integer.
-// It will return an error if the input is not an integer, blank, or "null".
+// It will unmarshal to a null Bool if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not "true", "false", blank, or "null".
 func (b *Bool) UnmarshalText(text []byte) error {
 	str := string(text)
+	if isNullText(text) {
+		str = ""
+	}
 	switch str {
 	case "", "null":
 		b.Valid = false
@@ -83,22 +150,28 @@ func (b *Bool) UnmarshalText(text []byte) error {
 }
 
 // MarshalJSON implements json.Marshaler.
-// It will encode null if this Bool is null.
+// It will encode null if this Bool is null, or its zero value if NullZero is set.
 func (b Bool) MarshalJSON() ([]byte, error) {
+	return b.AppendJSON(make([]byte, 0, 5))
+}
+
+// AppendJSON appends the JSON encoding of this Bool to dst, as MarshalJSON would encode it.
+// Encoders can reuse dst across calls to avoid allocating for every value.
+func (b Bool) AppendJSON(dst []byte) ([]byte, error) {
 	if !b.Valid {
-		return []byte("null"), nil
-	}
-	if !b.Bool {
-		return []byte("false"), nil
+		if NullZero {
+			return append(dst, "false"...), nil
+		}
+		return append(dst, "null"...), nil
 	}
-	return []byte("true"), nil
+	return strconv.AppendBool(dst, b.Bool), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It will encode a blank string if this Bool is null.
+// It will encode NullText, blank by default, if this Bool is null.
 func (b Bool) MarshalText() ([]byte, error) {
 	if !b.Valid {
-		return []byte{}, nil
+		return nullText(), nil
 	}
 	if !b.Bool {
 		return []byte("false"), nil
@@ -106,12 +179,73 @@ func (b Bool) MarshalText() ([]byte, error) {
 	return []byte("true"), nil
 }
 
+// Scan implements sql.Scanner.
+// In addition to native bool and integer values, it supports text-encoded
+// booleans as []byte or string, as returned by some MySQL drivers for TINYINT(1)
+// and by legacy text columns: anything strconv.ParseBool accepts
+// ("1", "t", "true", "0", "f", "false", ...) as well as "yes" and "no".
+// Values of named types, such as `type MyBool bool`, are scanned as their underlying type.
+func (b *Bool) Scan(value interface{}) error {
+	value = driverValue(value)
+	switch x := value.(type) {
+	case []byte:
+		return b.scanText(string(x))
+	case string:
+		return b.scanText(x)
+	case nil:
+		b.Bool, b.Valid = false, false
+		return nil
+	}
+	return b.NullBool.Scan(value)
+}
+
+func (b *Bool) scanText(str string) error {
+	v, err := strconv.ParseBool(str)
+	if err != nil {
+		switch strings.ToLower(str) {
+		case "yes":
+			v, err = true, nil
+		case "no":
+			v, err = false, nil
+		default:
+			b.Bool, b.Valid = false, false
+			return fmt.Errorf("null: cannot scan %q into null.Bool", str)
+		}
+	}
+	b.Bool, b.Valid = v, true
+	return nil
+}
+
 // SetValid changes this Bool's value and also sets it to be non-null.
 func (b *Bool) SetValid(v bool) {
 	b.Bool = v
 	b.Valid = true
 }
 
+// Set changes this Bool's value and validity at once.
+// Unlike SetValid, it can keep a value while marking this Bool null.
+func (b *Bool) Set(v bool, valid bool) {
+	b.Bool = v
+	b.Valid = valid
+}
+
+// SetNull sets this Bool to null, resetting its value to zero
+// so that stale data doesn't show through the Bool field.
+func (b *Bool) SetNull() {
+	b.Bool = false
+	b.Valid = false
+}
+
+// SetValidIf sets this Bool to v if cond is true, and to null otherwise.
+// Unlike Set, it resets the value to zero when cond is false.
+func (b *Bool) SetValidIf(v bool, cond bool) {
+	if !cond {
+		b.SetNull()
+		return
+	}
+	b.SetValid(v)
+}
+
 // Ptr returns a pointer to this Bool's value, or a nil pointer if this Bool is null.
 func (b Bool) Ptr() *bool {
 	if !b.Valid {
@@ -120,8 +254,96 @@ func (b Bool) Ptr() *bool {
 	return &b.Bool
 }
 
-// IsZero returns true for invalid Bools, for future omitempty support (Go 1.4?)
-// A non-null Bool with a 0 value will not be considered zero.
-func (b Bool) IsZero() bool {
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (b Bool) ValueOrZero() bool {
+	if !b.Valid {
+		return false
+	}
+	return b.Bool
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (b Bool) ValueOr(def bool) bool {
+	if !b.Valid {
+		return def
+	}
+	return b.Bool
+}
+
+// Equal returns true if both bools have the same value or are both null.
+func (b Bool) Equal(other Bool) bool {
+	return b.Valid == other.Valid && (!b.Valid || b.Bool == other.Bool)
+}
+
+// Changed returns true if this Bool differs from old: if one is null and the other isn't,
+// or if both are valid with different values. It is the inverse of Equal.
+func (b Bool) Changed(old Bool) bool {
+	return !b.Equal(old)
+}
+
+// Or returns this Bool if it is valid, otherwise other.
+func (b Bool) Or(other Bool) Bool {
+	if b.Valid {
+		return b
+	}
+	return other
+}
+
+// Merge copies patch into this Bool if patch is valid, and otherwise leaves it unchanged,
+// for applying partial updates where a null field means "keep the current value".
+func (b *Bool) Merge(patch Bool) {
+	if patch.Valid {
+		*b = patch
+	}
+}
+
+// Map returns a valid Bool holding f applied to this Bool's value,
+// or this Bool unchanged if it is null. f is not called for a null Bool.
+func (b Bool) Map(f func(bool) bool) Bool {
+	if !b.Valid {
+		return b
+	}
+	return BoolFrom(f(b.Bool))
+}
+
+// ToInt converts this Bool to an Int holding 1 for true and 0 for false.
+// A null Bool converts to a null Int.
+func (b Bool) ToInt() Int {
+	if !b.Valid {
+		return NewInt(0, false)
+	}
+	if b.Bool {
+		return IntFrom(1)
+	}
+	return IntFrom(0)
+}
+
+// String implements fmt.Stringer.
+// It returns "true" or "false", or NullDisplay if null.
+func (b Bool) String() string {
+	if !b.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatBool(b.Bool)
+}
+
+// IsNull returns true if this Bool is null.
+func (b Bool) IsNull() bool {
+	return !b.Valid
+}
+
+// Null is the same as IsNull.
+func (b Bool) Null() bool {
 	return !b.Valid
 }
+
+// IsValid returns true if this Bool is not null.
+func (b Bool) IsValid() bool {
+	return b.Valid
+}
+
+// IsZero returns true for null Bools and for valid ones holding false, for omitempty support.
+// Use IsValid to tell a null Bool from a valid zero one.
+func (b Bool) IsZero() bool {
+	return !b.Valid || !b.Bool
+}
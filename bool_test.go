@@ -1,7 +1,9 @@
 package null
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"testing"
 )
 
@@ -31,6 +33,46 @@ func TestBoolFromPtr(t *testing.T) {
 	assertNullBool(t, null, "BoolFromPtr(nil)")
 }
 
+func TestBoolFromSQL(t *testing.T) {
+	v := BoolFromSQL(sql.NullBool{Bool: true, Valid: true})
+	assertBool(t, v, "BoolFromSQL()")
+	if v.NullBool != (sql.NullBool{Bool: true, Valid: true}) {
+		t.Errorf("bad NullBool round trip: %v", v.NullBool)
+	}
+
+	null := BoolFromSQL(sql.NullBool{})
+	assertNullBool(t, null, "BoolFromSQL() null")
+	if null.NullBool != (sql.NullBool{}) {
+		t.Errorf("bad null NullBool round trip: %v", null.NullBool)
+	}
+}
+
+func TestBoolFromString(t *testing.T) {
+	b, err := BoolFromString("true")
+	maybePanic(err)
+	assertBool(t, b, "BoolFromString()")
+
+	f, err := BoolFromString("0")
+	maybePanic(err)
+	assertFalseBool(t, f, "BoolFromString() false")
+
+	null, err := BoolFromString("")
+	maybePanic(err)
+	assertNullBool(t, null, "BoolFromString(\"\")")
+
+	invalid, err := BoolFromString("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBool(t, invalid, "BoolFromString() invalid")
+}
+
+func TestMustBool(t *testing.T) {
+	assertBool(t, MustBool("true"), "MustBool()")
+	assertNullBool(t, MustBool(""), "MustBool(\"\")")
+	assertPanics(t, func() { MustBool("hello") }, "MustBool() invalid")
+}
+
 func TestUnmarshalBool(t *testing.T) {
 	var b Bool
 	err := json.Unmarshal(boolJSON, &b)
@@ -42,6 +84,11 @@ func TestUnmarshalBool(t *testing.T) {
 	maybePanic(err)
 	assertBool(t, nb, "sq.NullBool json")
 
+	invalidObj := BoolFrom(true)
+	err = json.Unmarshal([]byte(`{"Bool":true,"Valid":false}`), &invalidObj)
+	maybePanic(err)
+	assertNullBool(t, invalidObj, "sql.NullBool json with Valid false")
+
 	var null Bool
 	err = json.Unmarshal(nullJSON, &null)
 	maybePanic(err)
@@ -55,6 +102,71 @@ func TestUnmarshalBool(t *testing.T) {
 	assertNullBool(t, badType, "wrong type json")
 }
 
+func TestUnmarshalBoolNumber(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		want  Bool
+	}{
+		{`1`, BoolFrom(true)},
+		{`0`, BoolFrom(false)},
+		{`true`, BoolFrom(true)},
+		{`false`, BoolFrom(false)},
+		{`null`, NewBool(false, false)},
+	} {
+		var b Bool
+		err := json.Unmarshal([]byte(tc.input), &b)
+		maybePanic(err)
+		if b != tc.want {
+			t.Errorf("Unmarshal(%s) = %+v, want %+v", tc.input, b, tc.want)
+		}
+	}
+
+	for _, input := range []string{`2`, `-1`, `0.5`} {
+		b := BoolFrom(true)
+		if err := json.Unmarshal([]byte(input), &b); err == nil {
+			t.Errorf("expected error for %s", input)
+		}
+		assertNullBool(t, b, "bad number json "+input)
+	}
+}
+
+func TestBoolUnmarshalJSONStrict(t *testing.T) {
+	var v Bool
+	err := v.UnmarshalJSONStrict(boolJSON)
+	maybePanic(err)
+	assertBool(t, v, "strict json")
+
+	var null Bool
+	if err := null.UnmarshalJSONStrict(nullJSON); err == nil {
+		t.Error("expected error")
+	}
+	assertNullBool(t, null, "strict null json")
+}
+
+func TestBoolUnmarshalJSONValidate(t *testing.T) {
+	validate := func(v bool) error {
+		if !v {
+			return errTooBig
+		}
+		return nil
+	}
+
+	var v Bool
+	err := v.UnmarshalJSONValidate(boolJSON, validate)
+	assertValidated(t, err, nil, "validated json")
+	assertBool(t, v, "validated json")
+
+	var invalid Bool
+	err = invalid.UnmarshalJSONValidate([]byte(`false`), validate)
+	assertValidated(t, err, errTooBig, "invalid json")
+	assertNullBool(t, invalid, "invalid json")
+
+	var null Bool
+	err = null.UnmarshalJSONValidate(nullJSON, func(bool) error { return errTooBig })
+	assertValidated(t, err, nil, "null json")
+	assertNullBool(t, null, "null json")
+}
+
 func TestTextUnmarshalBool(t *testing.T) {
 	var b Bool
 	err := b.UnmarshalText([]byte("true"))
@@ -144,10 +256,114 @@ func TestBoolIsZero(t *testing.T) {
 	if !null.IsZero() {
 		t.Errorf("IsZero() should be true")
 	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
 
 	zero := NewBool(false, true)
-	if zero.IsZero() {
-		t.Errorf("IsZero() should be false")
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestBoolValueOrZero(t *testing.T) {
+	valid := BoolFrom(true)
+	if valid.ValueOrZero() != true {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewBool(true, false)
+	if invalid.ValueOrZero() != false {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestBoolEqual(t *testing.T) {
+	a := NewBool(true, false)
+	b := NewBool(true, false)
+	assertBoolEqualIsTrue(t, a, b)
+
+	a = NewBool(true, false)
+	b = NewBool(false, false)
+	assertBoolEqualIsTrue(t, a, b)
+
+	a = NewBool(true, true)
+	b = NewBool(true, true)
+	assertBoolEqualIsTrue(t, a, b)
+
+	a = NewBool(true, true)
+	b = NewBool(true, false)
+	assertBoolEqualIsFalse(t, a, b)
+
+	a = NewBool(true, true)
+	b = NewBool(false, true)
+	assertBoolEqualIsFalse(t, a, b)
+}
+
+func TestBoolValueOr(t *testing.T) {
+	if v := BoolFrom(false).ValueOr(true); v != false {
+		t.Error("unexpected ValueOr for valid false Bool", v)
+	}
+	if v := NewBool(false, false).ValueOr(true); v != true {
+		t.Error("unexpected ValueOr", v)
+	}
+}
+
+func TestBoolOr(t *testing.T) {
+	valid := BoolFrom(true)
+	null := NewBool(true, false)
+	assertFalseBool(t, BoolFrom(false).Or(valid), "valid false.Or()")
+	assertBool(t, null.Or(valid), "null.Or(valid)")
+	assertNullBool(t, null.Or(null), "null.Or(null)")
+	assertBool(t, null.Or(null).Or(valid).Or(BoolFrom(false)), "chained Or()")
+}
+
+func TestBoolToInt(t *testing.T) {
+	for _, tc := range []struct {
+		in   Bool
+		want Int
+	}{
+		{BoolFrom(true), IntFrom(1)},
+		{BoolFrom(false), IntFrom(0)},
+		{NewBool(true, false), NewInt(0, false)},
+	} {
+		if got := tc.in.ToInt(); got != tc.want {
+			t.Errorf("%v.ToInt() = %#v, want %#v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestBoolMap(t *testing.T) {
+	assertBool(t, BoolFrom(false).Map(func(v bool) bool { return !v }), "Map()")
+
+	called := false
+	null := NewBool(false, false).Map(func(v bool) bool {
+		called = true
+		return v
+	})
+	assertNullBool(t, null, "null Map()")
+	if called {
+		t.Error("Map() should not call f for a null Bool")
+	}
+}
+
+func TestBoolString(t *testing.T) {
+	b := BoolFrom(true)
+	if b.String() != "true" {
+		t.Errorf("bad String() output: %s\n", b.String())
+	}
+
+	f := BoolFrom(false)
+	if f.String() != "false" {
+		t.Errorf("bad String() output: %s\n", f.String())
+	}
+
+	null := NewBool(true, false)
+	if null.String() != "null" {
+		t.Errorf("bad null String() output: %s\n", null.String())
 	}
 }
 
@@ -158,6 +374,39 @@ func TestBoolSetValid(t *testing.T) {
 	assertBool(t, change, "SetValid()")
 }
 
+func TestBoolSet(t *testing.T) {
+	var b Bool
+	b.Set(true, true)
+	assertBool(t, b, "Set(x, true)")
+
+	b.Set(true, false)
+	assertNullBool(t, b, "Set(x, false)")
+	if b.Bool != true {
+		t.Errorf("Set(x, false) should keep the value, got %v", b.Bool)
+	}
+}
+
+func TestBoolSetNull(t *testing.T) {
+	b := BoolFrom(true)
+	b.SetNull()
+	assertNullBool(t, b, "SetNull()")
+	if b.Bool != false {
+		t.Errorf("SetNull() should reset the value, got %v", b.Bool)
+	}
+}
+
+func TestBoolSetValidIf(t *testing.T) {
+	var b Bool
+	b.SetValidIf(true, true)
+	assertBool(t, b, "SetValidIf(x, true)")
+
+	b.SetValidIf(true, false)
+	assertNullBool(t, b, "SetValidIf(x, false)")
+	if b.Bool {
+		t.Errorf("SetValidIf(x, false) should reset the value, got %v", b.Bool)
+	}
+}
+
 func TestBoolScan(t *testing.T) {
 	var b Bool
 	err := b.Scan(true)
@@ -170,6 +419,54 @@ func TestBoolScan(t *testing.T) {
 	assertNullBool(t, null, "scanned null")
 }
 
+func TestBoolScanNamed(t *testing.T) {
+	type namedBool bool
+	for _, src := range []interface{}{namedBool(true), namedString("true"), namedInt(1)} {
+		var b Bool
+		err := b.Scan(src)
+		maybePanic(err)
+		assertBool(t, b, fmt.Sprintf("scanned %T", src))
+	}
+}
+
+func TestBoolScanText(t *testing.T) {
+	for _, lit := range []string{"1", "t", "T", "true", "TRUE", "True", "yes", "YES"} {
+		var s Bool
+		err := s.Scan(lit)
+		maybePanic(err)
+		assertBool(t, s, "scanned string "+lit)
+
+		var b Bool
+		err = b.Scan([]byte(lit))
+		maybePanic(err)
+		assertBool(t, b, "scanned []byte "+lit)
+	}
+
+	for _, lit := range []string{"0", "f", "F", "false", "FALSE", "False", "no", "No"} {
+		var s Bool
+		err := s.Scan(lit)
+		maybePanic(err)
+		assertFalseBool(t, s, "scanned string "+lit)
+
+		var b Bool
+		err = b.Scan([]byte(lit))
+		maybePanic(err)
+		assertFalseBool(t, b, "scanned []byte "+lit)
+	}
+
+	var i Bool
+	err := i.Scan(int64(1))
+	maybePanic(err)
+	assertBool(t, i, "scanned int64")
+
+	var invalid Bool
+	err = invalid.Scan([]byte("maybe"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBool(t, invalid, "scanned invalid text")
+}
+
 func assertBool(t *testing.T, b Bool, from string) {
 	if b.Bool != true {
 		t.Errorf("bad %s bool: %v ≠ %v\n", from, b.Bool, true)
@@ -193,3 +490,17 @@ func assertNullBool(t *testing.T, b Bool, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func assertBoolEqualIsTrue(t *testing.T, a, b Bool) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Bool{%v, Valid:%t} and Bool{%v, Valid:%t} should return true", a.Bool, a.Valid, b.Bool, b.Valid)
+	}
+}
+
+func assertBoolEqualIsFalse(t *testing.T, a, b Bool) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Bool{%v, Valid:%t} and Bool{%v, Valid:%t} should return false", a.Bool, a.Valid, b.Bool, b.Valid)
+	}
+}
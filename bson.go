@@ -0,0 +1,155 @@
+//go:build bson
+
+package null
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// This file adds BSON support using go.mongodb.org/mongo-driver.
+// It is only built with the bson build tag, so the core package has no MongoDB dependency.
+
+// bsonNull reports whether t is a BSON null or undefined value.
+func bsonNull(t bsontype.Type) bool {
+	return t == bsontype.Null || t == bsontype.Undefined
+}
+
+// bsonTypeError returns the error for BSON values of the wrong type.
+func bsonTypeError(t bsontype.Type, typ string) error {
+	return fmt.Errorf("bson: cannot unmarshal %v into Go value of type %s", t, typ)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this String is null.
+func (s String) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !s.Valid {
+		return bsontype.Null, nil, nil
+	}
+	return bsontype.String, bsoncore.AppendString(nil, s.String), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports string and null input.
+func (s *String) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if bsonNull(t) {
+		s.Valid = false
+		return nil
+	}
+	str, ok := bsoncore.Value{Type: t, Data: data}.StringValueOK()
+	if !ok {
+		s.Valid = false
+		return bsonTypeError(t, "null.String")
+	}
+	s.String, s.Valid = str, true
+	return nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Int is null.
+func (i Int) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !i.Valid {
+		return bsontype.Null, nil, nil
+	}
+	return bsontype.Int64, bsoncore.AppendInt64(nil, i.Int64), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports 32 and 64-bit integer and null input.
+func (i *Int) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if bsonNull(t) {
+		i.Valid = false
+		return nil
+	}
+	v := bsoncore.Value{Type: t, Data: data}
+	if n, ok := v.Int32OK(); ok {
+		i.Int64, i.Valid = int64(n), true
+		return nil
+	}
+	n, ok := v.Int64OK()
+	if !ok {
+		i.Valid = false
+		return bsonTypeError(t, "null.Int")
+	}
+	i.Int64, i.Valid = n, true
+	return nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Float is null.
+func (f Float) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !f.Valid {
+		return bsontype.Null, nil, nil
+	}
+	return bsontype.Double, bsoncore.AppendDouble(nil, f.Float64), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports double and null input.
+func (f *Float) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if bsonNull(t) {
+		f.Valid = false
+		return nil
+	}
+	n, ok := bsoncore.Value{Type: t, Data: data}.DoubleOK()
+	if !ok {
+		f.Valid = false
+		return bsonTypeError(t, "null.Float")
+	}
+	f.Float64, f.Valid = n, true
+	return nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !b.Valid {
+		return bsontype.Null, nil, nil
+	}
+	return bsontype.Boolean, bsoncore.AppendBoolean(nil, b.Bool), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports boolean and null input.
+func (b *Bool) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if bsonNull(t) {
+		b.Valid = false
+		return nil
+	}
+	v, ok := bsoncore.Value{Type: t, Data: data}.BooleanOK()
+	if !ok {
+		b.Valid = false
+		return bsonTypeError(t, "null.Bool")
+	}
+	b.Bool, b.Valid = v, true
+	return nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Time is null, and a BSON datetime otherwise.
+// BSON datetimes have millisecond precision.
+func (t Time) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !t.Valid {
+		return bsontype.Null, nil, nil
+	}
+	return bsontype.DateTime, bsoncore.AppendDateTime(nil, t.Time.UnixMilli()), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports datetime and null input. Decoded times are in UTC.
+func (t *Time) UnmarshalBSONValue(typ bsontype.Type, data []byte) error {
+	if bsonNull(typ) {
+		t.Valid = false
+		return nil
+	}
+	ms, ok := bsoncore.Value{Type: typ, Data: data}.DateTimeOK()
+	if !ok {
+		t.Valid = false
+		return bsonTypeError(typ, "null.Time")
+	}
+	t.Time, t.Valid = time.UnixMilli(ms).UTC(), true
+	return nil
+}
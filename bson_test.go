@@ -0,0 +1,93 @@
+//go:build bson
+
+package null
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type bsonRecord struct {
+	String String `bson:"string"`
+	Int    Int    `bson:"int"`
+	Float  Float  `bson:"float"`
+	Bool   Bool   `bson:"bool"`
+	Time   Time   `bson:"time"`
+}
+
+func TestBSONRoundTrip(t *testing.T) {
+	in := bsonRecord{
+		String: StringFrom("test"),
+		Int:    IntFrom(12345),
+		Float:  FloatFrom(1.2345),
+		Bool:   BoolFrom(true),
+		Time:   TimeFrom(timeValue),
+	}
+	data, err := bson.Marshal(in)
+	maybePanic(err)
+
+	var raw bson.M
+	err = bson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw["string"] != "test" || raw["int"] != int64(12345) || raw["float"] != 1.2345 || raw["bool"] != true {
+		t.Errorf("bad bson document: %v\n", raw)
+	}
+
+	var out bsonRecord
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	assertStr(t, out.String, "bson string")
+	assertInt(t, out.Int, "bson int")
+	assertFloat(t, out.Float, "bson float")
+	assertBool(t, out.Bool, "bson bool")
+	assertTime(t, out.Time, "bson time")
+}
+
+func TestBSONNull(t *testing.T) {
+	data, err := bson.Marshal(bsonRecord{})
+	maybePanic(err)
+
+	var raw bson.M
+	err = bson.Unmarshal(data, &raw)
+	maybePanic(err)
+	for k, v := range raw {
+		if v != nil {
+			t.Errorf("bad bson null %s: %v ≠ nil\n", k, v)
+		}
+	}
+
+	out := bsonRecord{
+		String: StringFrom("test"),
+		Int:    IntFrom(12345),
+		Float:  FloatFrom(1.2345),
+		Bool:   BoolFrom(true),
+		Time:   TimeFrom(timeValue),
+	}
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	assertNullStr(t, out.String, "bson null string")
+	assertNullInt(t, out.Int, "bson null int")
+	assertNullFloat(t, out.Float, "bson null float")
+	assertNullBool(t, out.Bool, "bson null bool")
+	assertNullTime(t, out.Time, "bson null time")
+}
+
+func TestBSONInt32(t *testing.T) {
+	data, err := bson.Marshal(bson.M{"int": int32(12345)})
+	maybePanic(err)
+	var out bsonRecord
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	assertInt(t, out.Int, "bson int32")
+}
+
+func TestBSONWrongType(t *testing.T) {
+	data, err := bson.Marshal(bson.M{"int": "hello"})
+	maybePanic(err)
+	var out bsonRecord
+	err = bson.Unmarshal(data, &out)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,174 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Byte is a nullable byte, for single-character columns such as 'Y'/'N' flags.
+// It will marshal to null if null, and to a one-character string otherwise.
+// Blank string input will be considered null.
+type Byte struct {
+	Byte  byte
+	Valid bool
+}
+
+// NewByte creates a new Byte
+func NewByte(b byte, valid bool) Byte {
+	return Byte{
+		Byte:  b,
+		Valid: valid,
+	}
+}
+
+// ByteFrom creates a new Byte that will always be valid.
+func ByteFrom(b byte) Byte {
+	return NewByte(b, true)
+}
+
+// ByteFromPtr creates a new Byte that will be null if b is nil.
+func ByteFromPtr(b *byte) Byte {
+	if b == nil {
+		return NewByte(0, false)
+	}
+	return NewByte(*b, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports one-character string and null input. Blank string input produces a null Byte.
+// It will return an error if the string is longer than one byte.
+func (b *Byte) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Byte")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		return b.UnmarshalText([]byte(x))
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Byte", reflect.TypeOf(v).Name())
+	}
+	b.Valid = false
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Byte if the input is blank or matches NullText.
+// It will return an error if the input is longer than one byte.
+func (b *Byte) UnmarshalText(text []byte) error {
+	if isNullText(text) {
+		b.Valid = false
+		return nil
+	}
+	if len(text) == 1 {
+		b.Byte, b.Valid = text[0], true
+		return nil
+	}
+	b.Valid = false
+	return fmt.Errorf("null: cannot use %q as a null.Byte: must be a single byte", text)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Byte is null.
+// Bytes above 0x7F are not valid UTF-8 on their own, and will not survive a JSON round trip.
+func (b Byte) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(string([]byte{b.Byte}))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Byte is null.
+func (b Byte) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return nullText(), nil
+	}
+	return []byte{b.Byte}, nil
+}
+
+// Scan implements sql.Scanner.
+// It supports one-byte []byte and string values, and int64 values from 0 to 255.
+// Blank []byte and string values produce a null Byte.
+func (b *Byte) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case []byte:
+		return b.UnmarshalText(x)
+	case string:
+		return b.UnmarshalText([]byte(x))
+	case int64:
+		if x < 0 || x > 255 {
+			b.Valid = false
+			return fmt.Errorf("null: cannot scan %d into null.Byte: out of range", x)
+		}
+		b.Byte, b.Valid = byte(x), true
+		return nil
+	case nil:
+		b.Valid = false
+		return nil
+	}
+	b.Valid = false
+	return fmt.Errorf("null: cannot scan type %T into null.Byte: %v", value, value)
+}
+
+// Value implements driver.Valuer.
+// It returns a one-character string, or nil if this Byte is null.
+func (b Byte) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return string([]byte{b.Byte}), nil
+}
+
+// SetValid changes this Byte's value and also sets it to be non-null.
+func (b *Byte) SetValid(v byte) {
+	b.Byte = v
+	b.Valid = true
+}
+
+// SetNull sets this Byte to null, resetting its value to zero.
+func (b *Byte) SetNull() {
+	b.Byte = 0
+	b.Valid = false
+}
+
+// Ptr returns a pointer to this Byte's value, or a nil pointer if this Byte is null.
+func (b Byte) Ptr() *byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Byte
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (b Byte) ValueOrZero() byte {
+	if !b.Valid {
+		return 0
+	}
+	return b.Byte
+}
+
+// IsNull returns true if this Byte is null.
+func (b Byte) IsNull() bool {
+	return !b.Valid
+}
+
+// Null is the same as IsNull.
+func (b Byte) Null() bool {
+	return !b.Valid
+}
+
+// IsValid returns true if this Byte is not null.
+func (b Byte) IsValid() bool {
+	return b.Valid
+}
+
+// IsZero returns true for null Bytes and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Byte from a valid zero one.
+func (b Byte) IsZero() bool {
+	return !b.Valid || b.Byte == 0
+}
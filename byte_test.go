@@ -0,0 +1,212 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var (
+	byteJSON = []byte(`"Y"`)
+)
+
+func TestByteFrom(t *testing.T) {
+	b := ByteFrom('Y')
+	assertByte(t, b, "ByteFrom()")
+
+	zero := ByteFrom(0)
+	if !zero.Valid {
+		t.Error("ByteFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestByteFromPtr(t *testing.T) {
+	n := byte('Y')
+	b := ByteFromPtr(&n)
+	assertByte(t, b, "ByteFromPtr()")
+
+	null := ByteFromPtr(nil)
+	assertNullByte(t, null, "ByteFromPtr(nil)")
+}
+
+func TestUnmarshalByte(t *testing.T) {
+	var b Byte
+	err := json.Unmarshal(byteJSON, &b)
+	maybePanic(err)
+	assertByte(t, b, "byte json")
+
+	var blank Byte
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullByte(t, blank, "blank string json")
+
+	var null Byte
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullByte(t, null, "null json")
+
+	var long Byte
+	err = json.Unmarshal(stringJSON, &long)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullByte(t, long, "multi-char json")
+
+	var badType Byte
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullByte(t, badType, "wrong type json")
+}
+
+func TestTextUnmarshalByte(t *testing.T) {
+	var b Byte
+	err := b.UnmarshalText([]byte("Y"))
+	maybePanic(err)
+	assertByte(t, b, "UnmarshalText() byte")
+
+	var blank Byte
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullByte(t, blank, "UnmarshalText() empty byte")
+
+	var long Byte
+	err = long.UnmarshalText([]byte("YN"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullByte(t, long, "UnmarshalText() multi-char")
+}
+
+func TestMarshalByte(t *testing.T) {
+	b := ByteFrom('Y')
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"Y"`, "non-empty json marshal")
+
+	data, err = b.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "Y", "non-empty text marshal")
+
+	null := ByteFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestBytePointer(t *testing.T) {
+	b := ByteFrom('Y')
+	ptr := b.Ptr()
+	if *ptr != 'Y' {
+		t.Errorf("bad %s byte: %#v ≠ %c\n", "pointer", ptr, 'Y')
+	}
+
+	null := NewByte(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s byte: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestByteValueOrZero(t *testing.T) {
+	valid := ByteFrom('Y')
+	if valid.ValueOrZero() != 'Y' {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewByte('Y', false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestByteIsZero(t *testing.T) {
+	b := ByteFrom('Y')
+	if b.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := ByteFromPtr(nil)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := ByteFrom(0)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestByteSetValid(t *testing.T) {
+	change := NewByte(0, false)
+	assertNullByte(t, change, "SetValid()")
+	change.SetValid('Y')
+	assertByte(t, change, "SetValid()")
+}
+
+func TestByteScan(t *testing.T) {
+	var b Byte
+	err := b.Scan([]byte("Y"))
+	maybePanic(err)
+	assertByte(t, b, "scanned []byte")
+
+	var s Byte
+	err = s.Scan("Y")
+	maybePanic(err)
+	assertByte(t, s, "scanned string")
+
+	var i Byte
+	err = i.Scan(int64('Y'))
+	maybePanic(err)
+	assertByte(t, i, "scanned int64")
+
+	var null Byte
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullByte(t, null, "scanned null")
+
+	var long Byte
+	err = long.Scan("YN")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullByte(t, long, "scanned multi-char")
+
+	var big Byte
+	err = big.Scan(int64(256))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullByte(t, big, "scanned out of range")
+
+	v, err := b.Value()
+	maybePanic(err)
+	if v != "Y" {
+		t.Errorf("bad byte value: %v ≠ %v\n", v, "Y")
+	}
+}
+
+func assertByte(t *testing.T, b Byte, from string) {
+	if b.Byte != 'Y' {
+		t.Errorf("bad %s byte: %c ≠ %c\n", from, b.Byte, 'Y')
+	}
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullByte(t *testing.T, b Byte, from string) {
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
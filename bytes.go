@@ -0,0 +1,190 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Bytes is a nullable []byte, for BLOB columns. It supports SQL and JSON serialization.
+// It will marshal to null if null, and to a base64 string otherwise, like encoding/json does for []byte.
+// A nil slice is null, but an empty non-nil slice is valid: BytesFrom(nil) is null
+// while BytesFrom([]byte{}) is valid and empty. Likewise, the JSON input "" produces a valid empty Bytes.
+type Bytes struct {
+	Bytes []byte
+	Valid bool
+}
+
+// NewBytes creates a new Bytes
+func NewBytes(b []byte, valid bool) Bytes {
+	return Bytes{
+		Bytes: b,
+		Valid: valid,
+	}
+}
+
+// BytesFrom creates a new Bytes that will be null if b is nil.
+func BytesFrom(b []byte) Bytes {
+	return NewBytes(b, b != nil)
+}
+
+// BytesFromPtr creates a new Bytes that will be null if b is nil.
+func BytesFromPtr(b *[]byte) Bytes {
+	if b == nil {
+		return NewBytes(nil, false)
+	}
+	return BytesFrom(*b)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports base64 string and null input.
+func (b *Bytes) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Bytes")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		return b.UnmarshalText([]byte(x))
+	case nil:
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	b.Valid = false
+	return fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Bytes", reflect.TypeOf(v).Name())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It decodes standard base64. Blank input produces a valid, empty Bytes,
+// and input matching a non-empty NullText produces a null Bytes.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	if matchesNullText(text) {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	dec := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	n, err := base64.StdEncoding.Decode(dec, text)
+	if err != nil {
+		b.Valid = false
+		return err
+	}
+	b.Bytes, b.Valid = dec[:n], true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Bytes is null.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	if b.Bytes == nil {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(b.Bytes)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Bytes is null, and standard base64 otherwise.
+func (b Bytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return nullText(), nil
+	}
+	out := make([]byte, base64.StdEncoding.EncodedLen(len(b.Bytes)))
+	base64.StdEncoding.Encode(out, b.Bytes)
+	return out, nil
+}
+
+// Scan implements sql.Scanner.
+// It supports []byte and string values. The scanned bytes are copied,
+// as database/sql may reuse the driver's buffer.
+func (b *Bytes) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case []byte:
+		b.Bytes, b.Valid = append([]byte{}, x...), true
+		return nil
+	case string:
+		b.Bytes, b.Valid = []byte(x), true
+		return nil
+	case nil:
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	b.Valid = false
+	return fmt.Errorf("null: cannot scan type %T into null.Bytes: %v", value, value)
+}
+
+// Value implements driver.Valuer.
+// It returns the []byte value, or nil if this Bytes is null.
+func (b Bytes) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	if b.Bytes == nil {
+		return []byte{}, nil
+	}
+	return b.Bytes, nil
+}
+
+// SetValid changes this Bytes' value and also sets it to be non-null.
+func (b *Bytes) SetValid(v []byte) {
+	b.Bytes = v
+	b.Valid = true
+}
+
+// SetNull sets this Bytes to null, resetting its value to zero.
+func (b *Bytes) SetNull() {
+	b.Bytes = nil
+	b.Valid = false
+}
+
+// Ptr returns a pointer to this Bytes' value, or a nil pointer if this Bytes is null.
+func (b Bytes) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bytes
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (b Bytes) ValueOrZero() []byte {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bytes
+}
+
+// Equal returns true if both have the same bytes or are both null.
+// A valid empty Bytes is not equal to a null one.
+func (b Bytes) Equal(other Bytes) bool {
+	return b.Valid == other.Valid && (!b.Valid || bytes.Equal(b.Bytes, other.Bytes))
+}
+
+// Changed returns true if this Bytes differs from old: if one is null and the other isn't,
+// or if both are valid with different values. It is the inverse of Equal.
+func (b Bytes) Changed(old Bytes) bool {
+	return !b.Equal(old)
+}
+
+// IsNull returns true if this Bytes is null.
+func (b Bytes) IsNull() bool {
+	return !b.Valid
+}
+
+// Null is the same as IsNull.
+func (b Bytes) Null() bool {
+	return !b.Valid
+}
+
+// IsValid returns true if this Bytes is not null.
+func (b Bytes) IsValid() bool {
+	return b.Valid
+}
+
+// IsZero returns true for null Bytes and for valid ones holding no bytes, for omitempty support.
+// Use IsValid to tell a null Bytes from a valid zero one.
+func (b Bytes) IsZero() bool {
+	return !b.Valid || len(b.Bytes) == 0
+}
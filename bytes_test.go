@@ -0,0 +1,251 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	bytesValue = []byte("hello\x00world")
+	bytesJSON  = []byte(`"aGVsbG8Ad29ybGQ="`)
+)
+
+func TestBytesFrom(t *testing.T) {
+	b := BytesFrom(bytesValue)
+	assertBytes(t, b, "BytesFrom()")
+
+	null := BytesFrom(nil)
+	assertNullBytes(t, null, "BytesFrom(nil)")
+
+	empty := BytesFrom([]byte{})
+	assertEmptyBytes(t, empty, "BytesFrom([]byte{})")
+}
+
+func TestBytesFromPtr(t *testing.T) {
+	v := bytesValue
+	b := BytesFromPtr(&v)
+	assertBytes(t, b, "BytesFromPtr()")
+
+	null := BytesFromPtr(nil)
+	assertNullBytes(t, null, "BytesFromPtr(nil)")
+}
+
+func TestUnmarshalBytes(t *testing.T) {
+	var b Bytes
+	err := json.Unmarshal(bytesJSON, &b)
+	maybePanic(err)
+	assertBytes(t, b, "base64 json")
+
+	var empty Bytes
+	err = json.Unmarshal(blankStringJSON, &empty)
+	maybePanic(err)
+	assertEmptyBytes(t, empty, "blank string json")
+
+	var null Bytes
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullBytes(t, null, "null json")
+
+	var invalid Bytes
+	err = json.Unmarshal([]byte(`"not base64!"`), &invalid)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBytes(t, invalid, "invalid base64 json")
+
+	var badType Bytes
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBytes(t, badType, "wrong type json")
+}
+
+func TestMarshalBytes(t *testing.T) {
+	b := BytesFrom(bytesValue)
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(bytesJSON), "non-empty json marshal")
+
+	// should match encoding/json's []byte encoding
+	std, err := json.Marshal(bytesValue)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(std), "encoding/json []byte marshal")
+
+	var out Bytes
+	err = json.Unmarshal(data, &out)
+	maybePanic(err)
+	assertBytes(t, out, "base64 round trip")
+
+	empty := BytesFrom([]byte{})
+	data, err = json.Marshal(empty)
+	maybePanic(err)
+	assertJSONEquals(t, data, `""`, "empty json marshal")
+
+	validNil := NewBytes(nil, true)
+	data, err = json.Marshal(validNil)
+	maybePanic(err)
+	assertJSONEquals(t, data, `""`, "valid nil json marshal")
+
+	null := BytesFrom(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = b.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "aGVsbG8Ad29ybGQ=", "non-empty text marshal")
+
+	var text Bytes
+	err = text.UnmarshalText(data)
+	maybePanic(err)
+	assertBytes(t, text, "text round trip")
+}
+
+func TestBytesScan(t *testing.T) {
+	src := append([]byte{}, bytesValue...)
+	var b Bytes
+	err := b.Scan(src)
+	maybePanic(err)
+	assertBytes(t, b, "scanned []byte")
+	src[0] = 'j'
+	assertBytes(t, b, "scanned []byte after the source buffer changed")
+
+	var s Bytes
+	err = s.Scan(string(bytesValue))
+	maybePanic(err)
+	assertBytes(t, s, "scanned string")
+
+	var empty Bytes
+	err = empty.Scan([]byte{})
+	maybePanic(err)
+	assertEmptyBytes(t, empty, "scanned empty []byte")
+
+	var null Bytes
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullBytes(t, null, "scanned null")
+
+	var wrong Bytes
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBytes(t, wrong, "scanned int64")
+
+	v, err := b.Value()
+	maybePanic(err)
+	if !bytes.Equal(v.([]byte), bytesValue) {
+		t.Errorf("bad bytes value: %v ≠ %v\n", v, bytesValue)
+	}
+
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null bytes value: %v ≠ nil\n", v)
+	}
+}
+
+func TestBytesScanReusedBuffer(t *testing.T) {
+	// database/sql may hand each row's column to Scan in the same driver buffer
+	buf := append([]byte{}, bytesValue...)
+	var first Bytes
+	maybePanic(first.Scan(buf))
+
+	buf = append(buf[:0], "other"...)
+	var second Bytes
+	maybePanic(second.Scan(buf))
+
+	assertBytes(t, first, "first row after scanning the second")
+	if string(second.Bytes) != "other" {
+		t.Errorf("bad second row: %s ≠ %s\n", second.Bytes, "other")
+	}
+}
+
+func TestBytesPointer(t *testing.T) {
+	b := BytesFrom(bytesValue)
+	ptr := b.Ptr()
+	if !bytes.Equal(*ptr, bytesValue) {
+		t.Errorf("bad %s bytes: %#v ≠ %v\n", "pointer", ptr, bytesValue)
+	}
+
+	null := BytesFrom(nil)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s bytes: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestBytesValueOrZero(t *testing.T) {
+	valid := BytesFrom(bytesValue)
+	if !bytes.Equal(valid.ValueOrZero(), bytesValue) {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewBytes(bytesValue, false)
+	if invalid.ValueOrZero() != nil {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestBytesEqual(t *testing.T) {
+	if !BytesFrom(bytesValue).Equal(BytesFrom(append([]byte{}, bytesValue...))) {
+		t.Error("Equal() of identical Bytes should return true")
+	}
+	if !BytesFrom(nil).Equal(NewBytes(bytesValue, false)) {
+		t.Error("Equal() of null Bytes should return true")
+	}
+	if BytesFrom([]byte{}).Equal(BytesFrom(nil)) {
+		t.Error("Equal() of empty and null Bytes should return false")
+	}
+}
+
+func TestBytesIsZero(t *testing.T) {
+	if BytesFrom(bytesValue).IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+	if !BytesFrom([]byte{}).IsZero() {
+		t.Errorf("IsZero() should be true for empty Bytes")
+	}
+	if !BytesFrom([]byte{}).IsValid() {
+		t.Errorf("IsValid() should be true for empty Bytes")
+	}
+	if !BytesFrom(nil).IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if BytesFrom(nil).IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+}
+
+func TestBytesSetValid(t *testing.T) {
+	var change Bytes
+	assertNullBytes(t, change, "SetValid()")
+	change.SetValid(bytesValue)
+	assertBytes(t, change, "SetValid()")
+}
+
+func assertBytes(t *testing.T, b Bytes, from string) {
+	if !bytes.Equal(b.Bytes, bytesValue) {
+		t.Errorf("bad %s bytes: %q ≠ %q\n", from, b.Bytes, bytesValue)
+	}
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertEmptyBytes(t *testing.T, b Bytes, from string) {
+	if len(b.Bytes) != 0 {
+		t.Errorf("bad %s bytes: %q should be empty\n", from, b.Bytes)
+	}
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullBytes(t *testing.T, b Bytes, from string) {
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
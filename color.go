@@ -0,0 +1,187 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Color is a nullable RGB color, stored as 0xRRGGBB.
+// It will marshal to null if null, and to a "#rrggbb" string otherwise.
+// Blank string input will be considered null.
+type Color struct {
+	Color uint32
+	Valid bool
+}
+
+// NewColor creates a new Color
+func NewColor(c uint32, valid bool) Color {
+	return Color{
+		Color: c,
+		Valid: valid,
+	}
+}
+
+// ColorFrom creates a new Color that will always be valid.
+func ColorFrom(c uint32) Color {
+	return NewColor(c, true)
+}
+
+// ColorFromPtr creates a new Color that will be null if c is nil.
+func ColorFromPtr(c *uint32) Color {
+	if c == nil {
+		return NewColor(0, false)
+	}
+	return NewColor(*c, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports "#rrggbb" and "#rgb" string and null input. Blank string input produces a null Color.
+func (c *Color) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Color")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		return c.UnmarshalText([]byte(x))
+	case nil:
+		c.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Color", reflect.TypeOf(v).Name())
+	}
+	c.Valid = false
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Color if the input is blank or matches NullText.
+// It accepts "#rrggbb" and the "#rgb" shorthand, in either case,
+// and will return an error for anything else.
+func (c *Color) UnmarshalText(text []byte) error {
+	if isNullText(text) {
+		c.Valid = false
+		return nil
+	}
+	c.Valid = false
+	if text[0] != '#' || (len(text) != 4 && len(text) != 7) {
+		return fmt.Errorf("null: cannot use %q as a null.Color: must be #rgb or #rrggbb", text)
+	}
+	hex := string(text[1:])
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return fmt.Errorf("null: cannot use %q as a null.Color: invalid hex", text)
+	}
+	c.Color, c.Valid = uint32(n), true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Color is null.
+func (c Color) MarshalJSON() ([]byte, error) {
+	if !c.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + c.hex() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Color is null.
+func (c Color) MarshalText() ([]byte, error) {
+	if !c.Valid {
+		return nullText(), nil
+	}
+	return []byte(c.hex()), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports int64 values from 0 to 0xFFFFFF, as well as "#rrggbb" and "#rgb" []byte and string values.
+// Blank []byte and string values produce a null Color.
+func (c *Color) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case int64:
+		if x < 0 || x > 0xFFFFFF {
+			c.Valid = false
+			return fmt.Errorf("null: cannot scan %d into null.Color: out of range", x)
+		}
+		c.Color, c.Valid = uint32(x), true
+		return nil
+	case []byte:
+		return c.UnmarshalText(x)
+	case string:
+		return c.UnmarshalText([]byte(x))
+	case nil:
+		c.Valid = false
+		return nil
+	}
+	c.Valid = false
+	return fmt.Errorf("null: cannot scan type %T into null.Color: %v", value, value)
+}
+
+// Value implements driver.Valuer.
+// It returns 0xRRGGBB as an int64, or nil if this Color is null.
+func (c Color) Value() (driver.Value, error) {
+	if !c.Valid {
+		return nil, nil
+	}
+	return int64(c.Color), nil
+}
+
+// SetValid changes this Color's value and also sets it to be non-null.
+func (c *Color) SetValid(v uint32) {
+	c.Color = v
+	c.Valid = true
+}
+
+// SetNull sets this Color to null, resetting its value to zero.
+func (c *Color) SetNull() {
+	c.Color = 0
+	c.Valid = false
+}
+
+// Ptr returns a pointer to this Color's value, or a nil pointer if this Color is null.
+func (c Color) Ptr() *uint32 {
+	if !c.Valid {
+		return nil
+	}
+	return &c.Color
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (c Color) ValueOrZero() uint32 {
+	if !c.Valid {
+		return 0
+	}
+	return c.Color
+}
+
+// IsNull returns true if this Color is null.
+func (c Color) IsNull() bool {
+	return !c.Valid
+}
+
+// Null is the same as IsNull.
+func (c Color) Null() bool {
+	return !c.Valid
+}
+
+// IsValid returns true if this Color is not null.
+func (c Color) IsValid() bool {
+	return c.Valid
+}
+
+// IsZero returns true for null Colors and for valid ones holding black, for omitempty support.
+// Use IsValid to tell a null Color from a valid zero one.
+func (c Color) IsZero() bool {
+	return !c.Valid || c.Color == 0
+}
+
+// hex returns this Color as a lowercase "#rrggbb" string.
+func (c Color) hex() string {
+	return fmt.Sprintf("#%06x", c.Color)
+}
@@ -0,0 +1,184 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var (
+	colorJSON      = []byte(`"#1a2b3c"`)
+	colorValue     = uint32(0x1a2b3c)
+	shortColorJSON = []byte(`"#ABC"`)
+)
+
+func TestColorFrom(t *testing.T) {
+	c := ColorFrom(colorValue)
+	assertColor(t, c, "ColorFrom()")
+
+	zero := ColorFrom(0)
+	if !zero.Valid {
+		t.Error("ColorFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestColorFromPtr(t *testing.T) {
+	v := colorValue
+	c := ColorFromPtr(&v)
+	assertColor(t, c, "ColorFromPtr()")
+
+	null := ColorFromPtr(nil)
+	assertNullColor(t, null, "ColorFromPtr(nil)")
+}
+
+func TestUnmarshalColor(t *testing.T) {
+	var c Color
+	err := json.Unmarshal(colorJSON, &c)
+	maybePanic(err)
+	assertColor(t, c, "color json")
+
+	var short Color
+	err = json.Unmarshal(shortColorJSON, &short)
+	maybePanic(err)
+	if !short.Valid || short.Color != 0xaabbcc {
+		t.Errorf("bad shorthand color: %#x ≠ %#x\n", short.Color, 0xaabbcc)
+	}
+
+	var blank Color
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullColor(t, blank, "blank string json")
+
+	var null Color
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullColor(t, null, "null json")
+
+	for _, bad := range []string{`"1a2b3c"`, `"#1a2b"`, `"#1a2b3g"`, `"#+12345"`, `16777215`, `true`} {
+		invalid := ColorFrom(colorValue)
+		if err := json.Unmarshal([]byte(bad), &invalid); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+		assertNullColor(t, invalid, bad)
+	}
+}
+
+func TestMarshalColor(t *testing.T) {
+	c := ColorFrom(colorValue)
+	data, err := json.Marshal(c)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(colorJSON), "non-empty json marshal")
+
+	data, err = ColorFrom(0xff).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "#0000ff", "padded text marshal")
+
+	null := ColorFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestColorScan(t *testing.T) {
+	var c Color
+	err := c.Scan(int64(colorValue))
+	maybePanic(err)
+	assertColor(t, c, "scanned int64")
+
+	var s Color
+	err = s.Scan("#1A2B3C")
+	maybePanic(err)
+	assertColor(t, s, "scanned string")
+
+	var null Color
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullColor(t, null, "scanned null")
+
+	var wrong Color
+	err = wrong.Scan(int64(0x1000000))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullColor(t, wrong, "scanned out of range int64")
+
+	v, err := c.Value()
+	maybePanic(err)
+	if v != int64(colorValue) {
+		t.Errorf("bad color value: %v ≠ %v\n", v, int64(colorValue))
+	}
+}
+
+func TestColorPointer(t *testing.T) {
+	c := ColorFrom(colorValue)
+	ptr := c.Ptr()
+	if *ptr != colorValue {
+		t.Errorf("bad %s color: %#v ≠ %v\n", "pointer", ptr, colorValue)
+	}
+
+	null := NewColor(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s color: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestColorValueOrZero(t *testing.T) {
+	valid := ColorFrom(colorValue)
+	if valid.ValueOrZero() != colorValue {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewColor(colorValue, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestColorIsZero(t *testing.T) {
+	c := ColorFrom(colorValue)
+	if c.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := ColorFromPtr(nil)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := ColorFrom(0)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestColorSetValid(t *testing.T) {
+	change := NewColor(0, false)
+	assertNullColor(t, change, "SetValid()")
+	change.SetValid(colorValue)
+	assertColor(t, change, "SetValid()")
+}
+
+func assertColor(t *testing.T, c Color, from string) {
+	if c.Color != colorValue {
+		t.Errorf("bad %s color: %#x ≠ %#x\n", from, c.Color, colorValue)
+	}
+	if !c.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullColor(t *testing.T, c Color, from string) {
+	if c.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
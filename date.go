@@ -0,0 +1,193 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// dateFormat is the layout used to encode and decode Dates.
+const dateFormat = "2006-01-02"
+
+// Date is a nullable calendar date. It supports SQL and JSON serialization.
+// The time of day and time zone are discarded: Dates are stored as midnight UTC.
+// It will marshal to null if null, and to a "2006-01-02" string otherwise.
+// Blank string input will be considered null.
+type Date struct {
+	sql.NullTime
+}
+
+// NewDate creates a new Date from the calendar date of t.
+func NewDate(t time.Time, valid bool) Date {
+	return Date{
+		NullTime: sql.NullTime{
+			Time:  truncateDate(t),
+			Valid: valid,
+		},
+	}
+}
+
+// DateFrom creates a new Date that will always be valid.
+func DateFrom(t time.Time) Date {
+	return NewDate(t, true)
+}
+
+// DateFromPtr creates a new Date that will be null if t is nil.
+func DateFromPtr(t *time.Time) Date {
+	if t == nil {
+		return NewDate(time.Time{}, false)
+	}
+	return NewDate(*t, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports "2006-01-02" string and null input. Blank string input produces a null Date.
+// It also supports unmarshalling a sql.NullTime.
+func (d *Date) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Date")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		if x == "" {
+			d.Valid = false
+			return nil
+		}
+		d.Time, err = time.Parse(dateFormat, x)
+	case map[string]interface{}:
+		d.NullTime = sql.NullTime{}
+		err = json.Unmarshal(data, &d.NullTime)
+		d.Time = truncateDate(d.Time)
+		d.Valid = err == nil && d.Valid
+		return err
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Date", reflect.TypeOf(v).Name())
+	}
+	d.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Date if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not a "2006-01-02" date.
+func (d *Date) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		d.Valid = false
+		return nil
+	}
+	var err error
+	d.Time, err = time.Parse(dateFormat, str)
+	d.Valid = err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Date is null.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.Time.Format(dateFormat) + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Date is null.
+func (d Date) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return nullText(), nil
+	}
+	return []byte(d.Time.Format(dateFormat)), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports time.Time values as well as "2006-01-02" strings and []byte,
+// which some drivers return for DATE columns.
+func (d *Date) Scan(value interface{}) error {
+	var err error
+	switch x := value.(type) {
+	case time.Time:
+		d.Time = truncateDate(x)
+	case []byte:
+		d.Time, err = time.Parse(dateFormat, string(x))
+	case string:
+		d.Time, err = time.Parse(dateFormat, x)
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.Date: %v", value, value)
+	}
+	d.Valid = err == nil
+	return err
+}
+
+// Value implements driver.Valuer.
+// It returns midnight UTC of this Date, or nil if this Date is null.
+func (d Date) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return truncateDate(d.Time), nil
+}
+
+// SetValid changes this Date's value and also sets it to be non-null.
+func (d *Date) SetValid(t time.Time) {
+	d.Time = truncateDate(t)
+	d.Valid = true
+}
+
+// SetNull sets this Date to null, resetting its value to zero.
+func (d *Date) SetNull() {
+	d.Time = time.Time{}
+	d.Valid = false
+}
+
+// Ptr returns a pointer to this Date's value, or a nil pointer if this Date is null.
+func (d Date) Ptr() *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Date) ValueOrZero() time.Time {
+	if !d.Valid {
+		return time.Time{}
+	}
+	return d.Time
+}
+
+// IsNull returns true if this Date is null.
+func (d Date) IsNull() bool {
+	return !d.Valid
+}
+
+// Null is the same as IsNull.
+func (d Date) Null() bool {
+	return !d.Valid
+}
+
+// IsValid returns true if this Date is not null.
+func (d Date) IsValid() bool {
+	return d.Valid
+}
+
+// IsZero returns true for null Dates and for valid ones holding the zero time, for omitempty support.
+// Use IsValid to tell a null Date from a valid zero one.
+func (d Date) IsZero() bool {
+	return !d.Valid || d.Time.IsZero()
+}
+
+// truncateDate returns midnight UTC of t's calendar date.
+func truncateDate(t time.Time) time.Time {
+	y, m, day := t.Date()
+	return time.Date(y, m, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,224 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var (
+	dateString = "2012-12-21"
+	dateJSON   = []byte(`"` + dateString + `"`)
+	dateValue  = time.Date(2012, time.December, 21, 0, 0, 0, 0, time.UTC)
+)
+
+func TestDateFrom(t *testing.T) {
+	d := DateFrom(time.Date(2012, time.December, 21, 21, 21, 21, 0, time.FixedZone("test", -5*60*60)))
+	assertDate(t, d, "DateFrom() time.Time")
+}
+
+func TestDateFromPtr(t *testing.T) {
+	d := DateFromPtr(&dateValue)
+	assertDate(t, d, "DateFromPtr() time")
+
+	null := DateFromPtr(nil)
+	assertNullDate(t, null, "DateFromPtr(nil)")
+}
+
+func TestUnmarshalDate(t *testing.T) {
+	var d Date
+	err := json.Unmarshal(dateJSON, &d)
+	maybePanic(err)
+	assertDate(t, d, "date json")
+
+	var blank Date
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullDate(t, blank, "blank string json")
+
+	var null Date
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDate(t, null, "null json")
+
+	var invalid Date
+	err = json.Unmarshal(timeJSON, &invalid)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDate(t, invalid, "timestamp json")
+
+	var badType Date
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDate(t, badType, "wrong type json")
+
+	var obj Date
+	err = json.Unmarshal([]byte(`{"Time":"2020-01-02T00:00:00Z","Valid":true}`), &obj)
+	maybePanic(err)
+	if !obj.Valid || !obj.Time.Equal(time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("bad sql.NullTime json: %v", obj)
+	}
+
+	objNull := DateFrom(dateValue)
+	err = json.Unmarshal([]byte(`{"Time":"2020-01-02T00:00:00Z","Valid":false}`), &objNull)
+	maybePanic(err)
+	assertNullDate(t, objNull, "sql.NullTime json with Valid false")
+
+	partial := DateFrom(dateValue)
+	err = json.Unmarshal([]byte(`{"Valid":true}`), &partial)
+	maybePanic(err)
+	if !partial.Valid || !partial.Time.IsZero() {
+		t.Errorf("partial sql.NullTime json kept stale fields: %v", partial)
+	}
+}
+
+func TestTextUnmarshalDate(t *testing.T) {
+	var d Date
+	err := d.UnmarshalText([]byte(dateString))
+	maybePanic(err)
+	assertDate(t, d, "UnmarshalText() date")
+
+	var blank Date
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullDate(t, blank, "blank text")
+}
+
+func TestMarshalDate(t *testing.T) {
+	d := DateFrom(dateValue)
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(dateJSON), "non-empty json marshal")
+
+	data, err = d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, dateString, "non-empty text marshal")
+
+	null := DateFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, `null`, "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestDateScan(t *testing.T) {
+	var d Date
+	err := d.Scan(time.Date(2012, time.December, 21, 21, 21, 21, 0, time.UTC))
+	maybePanic(err)
+	assertDate(t, d, "scanned time")
+
+	var b Date
+	err = b.Scan([]byte(dateString))
+	maybePanic(err)
+	assertDate(t, b, "scanned []byte")
+
+	var s Date
+	err = s.Scan(dateString)
+	maybePanic(err)
+	assertDate(t, s, "scanned string")
+
+	var null Date
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullDate(t, null, "scanned null")
+
+	var wrong Date
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDate(t, wrong, "scanned int64")
+}
+
+func TestDateValue(t *testing.T) {
+	d := DateFrom(time.Date(2012, time.December, 21, 21, 21, 21, 0, time.FixedZone("test", -5*60*60)))
+	v, err := d.Value()
+	maybePanic(err)
+	if v != dateValue {
+		t.Errorf("bad date value: %v ≠ %v\n", v, dateValue)
+	}
+
+	var null Date
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null date value: %v ≠ nil\n", v)
+	}
+}
+
+func TestDatePointer(t *testing.T) {
+	d := DateFrom(dateValue)
+	ptr := d.Ptr()
+	if *ptr != dateValue {
+		t.Errorf("bad %s date: %#v ≠ %v\n", "pointer", ptr, dateValue)
+	}
+
+	var null Date
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s date: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestDateValueOrZero(t *testing.T) {
+	valid := DateFrom(dateValue)
+	if valid.ValueOrZero() != dateValue {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewDate(dateValue, false)
+	if !invalid.ValueOrZero().IsZero() {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestDateIsZero(t *testing.T) {
+	d := DateFrom(dateValue)
+	if d.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := DateFromPtr(nil)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := NewDate(time.Time{}, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestDateSetValid(t *testing.T) {
+	var d Date
+	assertNullDate(t, d, "SetValid()")
+	d.SetValid(time.Date(2012, time.December, 21, 21, 21, 21, 0, time.UTC))
+	assertDate(t, d, "SetValid()")
+}
+
+func assertDate(t *testing.T, d Date, from string) {
+	if d.Time != dateValue {
+		t.Errorf("bad %v date: %v ≠ %v\n", from, d.Time, dateValue)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDate(t *testing.T, d Date, from string) {
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
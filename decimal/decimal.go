@@ -0,0 +1,178 @@
+// Package decimal provides a nullable arbitrary-precision decimal type
+// built on github.com/shopspring/decimal, for exact money and quantity fields.
+package decimal
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/shopspring/decimal"
+)
+
+// Decimal is a nullable decimal.Decimal. It supports SQL and JSON serialization.
+// It will marshal to null if null, and to a numeric string such as "123.4500" otherwise.
+// Trailing zeros are kept, so the scale of the value survives a round trip.
+// Blank string input will be considered null.
+type Decimal struct {
+	decimal.NullDecimal
+}
+
+// NewDecimal creates a new Decimal
+func NewDecimal(d decimal.Decimal, valid bool) Decimal {
+	return Decimal{
+		NullDecimal: decimal.NullDecimal{
+			Decimal: d,
+			Valid:   valid,
+		},
+	}
+}
+
+// DecimalFrom creates a new Decimal that will always be valid.
+func DecimalFrom(d decimal.Decimal) Decimal {
+	return NewDecimal(d, true)
+}
+
+// DecimalFromPtr creates a new Decimal that will be null if d is nil.
+func DecimalFromPtr(d *decimal.Decimal) Decimal {
+	if d == nil {
+		return NewDecimal(decimal.Zero, false)
+	}
+	return NewDecimal(*d, true)
+}
+
+// DecimalFromString creates a new Decimal by parsing s.
+// It will be null if s is blank, and an error is returned if s is not a number.
+func DecimalFromString(s string) (Decimal, error) {
+	var d Decimal
+	err := d.UnmarshalText([]byte(s))
+	return d, err
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports numeric string, number, and null input. Blank string input produces a null Decimal.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	var err error
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		return d.UnmarshalText([]byte(x))
+	case float64:
+		// Parse the original text rather than x to avoid losing precision to float64
+		d.Decimal, err = decimal.NewFromString(string(data))
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type decimal.Decimal", reflect.TypeOf(v).Name())
+	}
+	d.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Decimal if the input is blank or "null".
+// It will return an error if the input is not a number.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		d.Valid = false
+		return nil
+	}
+	var err error
+	d.Decimal, err = decimal.NewFromString(str)
+	d.Valid = err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Decimal is null.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.string() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Decimal is null.
+func (d Decimal) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.string()), nil
+}
+
+// Value implements driver.Valuer.
+// It returns the numeric string, keeping trailing zeros, or nil if this Decimal is null.
+func (d Decimal) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.string(), nil
+}
+
+// string formats the value with as many fractional digits as its exponent calls for.
+// decimal.Decimal.String drops trailing zeros, which would turn "123.4500" into "123.45".
+func (d Decimal) string() string {
+	if exp := d.Decimal.Exponent(); exp < 0 {
+		return d.Decimal.StringFixed(-exp)
+	}
+	return d.Decimal.String()
+}
+
+// SetValid changes this Decimal's value and also sets it to be non-null.
+func (d *Decimal) SetValid(v decimal.Decimal) {
+	d.Decimal = v
+	d.Valid = true
+}
+
+// SetNull sets this Decimal to null, resetting its value to zero.
+func (d *Decimal) SetNull() {
+	d.Decimal = decimal.Zero
+	d.Valid = false
+}
+
+// Ptr returns a pointer to this Decimal's value, or a nil pointer if this Decimal is null.
+func (d Decimal) Ptr() *decimal.Decimal {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Decimal
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Decimal) ValueOrZero() decimal.Decimal {
+	if !d.Valid {
+		return decimal.Zero
+	}
+	return d.Decimal
+}
+
+// Equal returns true if both Decimals are numerically equal or are both null.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Valid == other.Valid && (!d.Valid || d.Decimal.Equal(other.Decimal))
+}
+
+// IsNull returns true if this Decimal is null.
+func (d Decimal) IsNull() bool {
+	return !d.Valid
+}
+
+// Null is the same as IsNull.
+func (d Decimal) Null() bool {
+	return !d.Valid
+}
+
+// IsValid returns true if this Decimal is not null.
+func (d Decimal) IsValid() bool {
+	return d.Valid
+}
+
+// IsZero returns true for null Decimals and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Decimal from a valid zero one.
+func (d Decimal) IsZero() bool {
+	return !d.Valid || d.Decimal.IsZero()
+}
@@ -0,0 +1,196 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/shopspring/decimal"
+)
+
+var _ null.Nullable = (*Decimal)(nil)
+
+var (
+	decimalString = "123.4500"
+	decimalJSON   = []byte(`"` + decimalString + `"`)
+	nullJSON      = []byte(`null`)
+	blankJSON     = []byte(`""`)
+)
+
+func TestDecimalFrom(t *testing.T) {
+	d := DecimalFrom(decimal.RequireFromString(decimalString))
+	assertDecimal(t, d, "DecimalFrom()")
+}
+
+func TestDecimalFromPtr(t *testing.T) {
+	v := decimal.RequireFromString(decimalString)
+	d := DecimalFromPtr(&v)
+	assertDecimal(t, d, "DecimalFromPtr()")
+
+	null := DecimalFromPtr(nil)
+	assertNullDecimal(t, null, "DecimalFromPtr(nil)")
+}
+
+func TestUnmarshalDecimal(t *testing.T) {
+	var d Decimal
+	err := json.Unmarshal(decimalJSON, &d)
+	maybePanic(err)
+	assertDecimal(t, d, "decimal string json")
+
+	var num Decimal
+	err = json.Unmarshal([]byte(decimalString), &num)
+	maybePanic(err)
+	assertDecimal(t, num, "decimal number json")
+
+	var null Decimal
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDecimal(t, null, "null json")
+
+	for _, data := range [][]byte{{}, []byte(" \n\t")} {
+		blank := DecimalFrom(decimal.RequireFromString(decimalString))
+		err = blank.UnmarshalJSON(data)
+		maybePanic(err)
+		assertNullDecimal(t, blank, "empty json")
+	}
+
+	var blank Decimal
+	err = json.Unmarshal(blankJSON, &blank)
+	maybePanic(err)
+	assertNullDecimal(t, blank, "blank string json")
+
+	var invalid Decimal
+	err = json.Unmarshal([]byte(`"hello"`), &invalid)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDecimal(t, invalid, "invalid string json")
+
+	var badType Decimal
+	err = json.Unmarshal([]byte(`true`), &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDecimal(t, badType, "wrong type json")
+}
+
+func TestMarshalDecimal(t *testing.T) {
+	d, err := DecimalFromString(decimalString)
+	maybePanic(err)
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	if string(data) != string(decimalJSON) {
+		t.Errorf("bad json marshal: %s ≠ %s\n", data, decimalJSON)
+	}
+
+	var out Decimal
+	err = json.Unmarshal(data, &out)
+	maybePanic(err)
+	assertDecimal(t, out, "round trip json")
+
+	data, err = d.MarshalText()
+	maybePanic(err)
+	if string(data) != decimalString {
+		t.Errorf("bad text marshal: %s ≠ %s\n", data, decimalString)
+	}
+
+	null := DecimalFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	if string(data) != "null" {
+		t.Errorf("bad null json marshal: %s\n", data)
+	}
+}
+
+func TestDecimalScan(t *testing.T) {
+	var d Decimal
+	err := d.Scan([]byte(decimalString))
+	maybePanic(err)
+	assertDecimal(t, d, "scanned []byte")
+
+	var s Decimal
+	err = s.Scan(decimalString)
+	maybePanic(err)
+	assertDecimal(t, s, "scanned string")
+
+	var null Decimal
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullDecimal(t, null, "scanned null")
+
+	v, err := d.Value()
+	maybePanic(err)
+	if v != decimalString {
+		t.Errorf("bad decimal value: %v ≠ %v\n", v, decimalString)
+	}
+
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null decimal value: %v ≠ nil\n", v)
+	}
+}
+
+func TestDecimalEqual(t *testing.T) {
+	a := DecimalFrom(decimal.RequireFromString("123.45"))
+	b := DecimalFrom(decimal.RequireFromString(decimalString))
+	if !a.Equal(b) {
+		t.Error("Equal() should ignore trailing zeros")
+	}
+	if a.Equal(DecimalFromPtr(nil)) {
+		t.Error("Equal() of valid and null Decimals should be false")
+	}
+	if !DecimalFromPtr(nil).Equal(NewDecimal(decimal.NewFromInt(1), false)) {
+		t.Error("Equal() of null Decimals should be true")
+	}
+}
+
+func TestDecimalValueOrZero(t *testing.T) {
+	valid := DecimalFrom(decimal.RequireFromString(decimalString))
+	if !valid.ValueOrZero().Equal(decimal.RequireFromString(decimalString)) {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewDecimal(decimal.RequireFromString(decimalString), false)
+	if !invalid.ValueOrZero().IsZero() {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+	if invalid.Ptr() != nil || valid.Ptr() == nil {
+		t.Error("unexpected Ptr")
+	}
+	if !invalid.IsZero() || valid.IsZero() {
+		t.Error("unexpected IsZero")
+	}
+	zero := DecimalFrom(decimal.Zero)
+	if !zero.IsZero() || !zero.IsValid() || invalid.IsValid() {
+		t.Error("unexpected IsZero or IsValid")
+	}
+}
+
+func TestDecimalSetValid(t *testing.T) {
+	var change Decimal
+	assertNullDecimal(t, change, "SetValid()")
+	change.SetValid(decimal.RequireFromString(decimalString))
+	assertDecimal(t, change, "SetValid()")
+}
+
+func maybePanic(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assertDecimal(t *testing.T, d Decimal, from string) {
+	if d.string() != decimalString {
+		t.Errorf("bad %s decimal: %s ≠ %s\n", from, d.string(), decimalString)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDecimal(t *testing.T, d Decimal, from string) {
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
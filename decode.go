@@ -0,0 +1,149 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// jsonKind is the kind of JSON value, as determined by its first byte.
+type jsonKind int
+
+const (
+	jsonInvalid jsonKind = iota
+	jsonNull
+	jsonString
+	jsonNumber
+	jsonObject
+	jsonOther
+)
+
+// kindOf returns the kind of the JSON value data, which must not have leading whitespace.
+// It only looks at the first byte, so it lets UnmarshalJSON methods
+// dispatch without decoding their input into an interface{} first.
+// Empty input counts as null, since some decoders pass it for absent fields.
+func kindOf(data []byte) jsonKind {
+	if len(data) == 0 {
+		return jsonNull
+	}
+	switch c := data[0]; {
+	case c == 'n':
+		if string(data) != "null" {
+			return jsonInvalid
+		}
+		return jsonNull
+	case c == '"':
+		return jsonString
+	case c == '-' || (c >= '0' && c <= '9'):
+		return jsonNumber
+	case c == '{':
+		return jsonObject
+	}
+	return jsonOther
+}
+
+// jsonTypeError returns the error for a JSON value that can't be decoded into typ.
+func jsonTypeError(data []byte, typ string) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v == nil {
+		return fmt.Errorf("json: cannot unmarshal null into Go value of type %s", typ)
+	}
+	return fmt.Errorf("json: cannot unmarshal %v into Go value of type %s", reflect.TypeOf(v).Name(), typ)
+}
+
+// strictNullError returns the error for JSON input that UnmarshalJSONStrict rejects
+// because it would produce a null typ. It wraps ErrRequired.
+func strictNullError(data []byte, typ string) error {
+	return &UnmarshalError{Type: typ, Value: string(bytes.TrimSpace(data)), Err: ErrRequired}
+}
+
+// validateError returns the error for JSON input that decoded into typ but failed
+// the validation func passed to UnmarshalJSONValidate. It wraps the validation error.
+func validateError(data []byte, typ string, err error) error {
+	return &UnmarshalError{Type: typ, Value: string(bytes.TrimSpace(data)), Err: err}
+}
+
+// unquoteJSON returns the contents of the JSON string literal data.
+// Strings without escape sequences are sliced directly instead of going through encoding/json.
+func unquoteJSON(data []byte) (string, error) {
+	if n := len(data); n >= 2 && data[n-1] == '"' && bytes.IndexByte(data[1:n-1], '\\') == -1 && bytes.IndexByte(data[1:n-1], '"') == -1 {
+		return string(data[1 : n-1]), nil
+	}
+	var str string
+	err := json.Unmarshal(data, &str)
+	return str, err
+}
+
+// unquoteNumber returns the contents of the JSON string literal data, for numeric types that
+// also accept numbers sent as strings, such as "42". The contents must be blank or a JSON number,
+// so strings like "NaN", "+1" or " 1" that strconv would otherwise accept are an error.
+func unquoteNumber(data []byte, typ string) (string, error) {
+	str, err := unquoteJSON(data)
+	if err != nil {
+		return "", err
+	}
+	if n := []byte(str); str != "" && (kindOf(n) != jsonNumber || !json.Valid(n)) {
+		return "", fmt.Errorf("json: cannot unmarshal non-numeric string into Go value of type %s", typ)
+	}
+	return str, nil
+}
+
+// scanJSON is the canonical sql.Scanner path for JSON-backed types.
+// It decodes src, which should be JSON text as []byte or string, into dest with json.Unmarshal.
+// A nil src or a JSON null document is reported as invalid, with dest left untouched.
+// Any other type of src is an error, since drivers only hand back JSON as text.
+func scanJSON(dest interface{}, src interface{}) (valid bool, err error) {
+	var data []byte
+	switch x := src.(type) {
+	case []byte:
+		data = x
+	case string:
+		data = []byte(x)
+	case nil:
+		return false, nil
+	default:
+		return false, fmt.Errorf("null: cannot scan type %T as JSON: %v", src, src)
+	}
+	if kindOf(bytes.TrimSpace(data)) == jsonNull {
+		return false, nil
+	}
+	if err := json.Unmarshal(data, dest); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// driverValue converts value to the basic type it is defined as, if it is a named type
+// such as `type MyString string` that some ORMs and drivers pass to Scan.
+// Signed integers become int64, unsigned ones uint64, floats float64, and byte slices []byte.
+// Any other value, including nil and values that already have a basic type, is returned as is.
+func driverValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Type().PkgPath() == "" {
+		return value
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint()
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Bytes()
+		}
+	}
+	return value
+}
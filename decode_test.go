@@ -0,0 +1,69 @@
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestScanJSON(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	var b point
+	valid, err := scanJSON(&b, []byte(`{"X":1,"Y":2}`))
+	maybePanic(err)
+	if !valid || b != (point{1, 2}) {
+		t.Errorf("bad scanJSON() of []byte: %v (valid: %t)\n", b, valid)
+	}
+
+	var s point
+	valid, err = scanJSON(&s, `{"X":1,"Y":2}`)
+	maybePanic(err)
+	if !valid || s != (point{1, 2}) {
+		t.Errorf("bad scanJSON() of string: %v (valid: %t)\n", s, valid)
+	}
+
+	var null point
+	valid, err = scanJSON(&null, nil)
+	maybePanic(err)
+	if valid {
+		t.Error("scanJSON() of nil should be invalid")
+	}
+
+	var nullDoc point
+	valid, err = scanJSON(&nullDoc, []byte(" null "))
+	maybePanic(err)
+	if valid {
+		t.Error("scanJSON() of a JSON null document should be invalid")
+	}
+
+	var malformed point
+	valid, err = scanJSON(&malformed, []byte(`{"X":`))
+	if err == nil || valid {
+		t.Errorf("scanJSON() of malformed JSON should fail, got valid: %t, err: %v", valid, err)
+	}
+
+	var wrong point
+	valid, err = scanJSON(&wrong, int64(1))
+	if err == nil || valid {
+		t.Errorf("scanJSON() of int64 should fail, got valid: %t, err: %v", valid, err)
+	}
+}
+
+func TestUnmarshalBlankJSON(t *testing.T) {
+	for _, v := range validValues() {
+		for _, data := range [][]byte{{}, []byte(" \n\t")} {
+			typ := reflect.TypeOf(v)
+			ptr := reflect.New(typ)
+			ptr.Elem().Set(reflect.ValueOf(v))
+			if err := ptr.Interface().(json.Unmarshaler).UnmarshalJSON(data); err != nil {
+				t.Errorf("%s: unexpected error for %q: %v", typ.Name(), data, err)
+			}
+			if !ptr.Interface().(Nullable).IsNull() {
+				t.Errorf("%s: %q should decode to null", typ.Name(), data)
+			}
+		}
+	}
+}
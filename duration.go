@@ -0,0 +1,168 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Duration is a nullable time.Duration. It supports SQL and JSON serialization.
+// It will marshal to null if null, and to a string such as "1h30m0s" otherwise.
+// It is stored in SQL as an integer number of nanoseconds.
+type Duration struct {
+	Duration time.Duration
+	Valid    bool
+}
+
+// NewDuration creates a new Duration
+func NewDuration(d time.Duration, valid bool) Duration {
+	return Duration{
+		Duration: d,
+		Valid:    valid,
+	}
+}
+
+// DurationFrom creates a new Duration that will always be valid.
+func DurationFrom(d time.Duration) Duration {
+	return NewDuration(d, true)
+}
+
+// DurationFromPtr creates a new Duration that will be null if d is nil.
+func DurationFromPtr(d *time.Duration) Duration {
+	if d == nil {
+		return NewDuration(0, false)
+	}
+	return NewDuration(*d, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports duration strings such as "500ms", integer nanoseconds, and null input.
+// Blank string input produces a null Duration.
+func (d *Duration) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Duration")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		if x == "" {
+			d.Valid = false
+			return nil
+		}
+		d.Duration, err = time.ParseDuration(x)
+	case float64:
+		// Unmarshal again, directly to int64, to avoid intermediate float64
+		var n int64
+		err = json.Unmarshal(data, &n)
+		d.Duration = time.Duration(n)
+	case nil:
+		d.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Duration", reflect.TypeOf(v).Name())
+	}
+	d.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Duration if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not a duration string.
+func (d *Duration) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		d.Valid = false
+		return nil
+	}
+	var err error
+	d.Duration, err = time.ParseDuration(str)
+	d.Valid = err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Duration is null.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.Duration.String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Duration is null.
+func (d Duration) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return nullText(), nil
+	}
+	return []byte(d.Duration.String()), nil
+}
+
+// Scan implements sql.Scanner.
+// It expects an integer number of nanoseconds.
+func (d *Duration) Scan(value interface{}) error {
+	var n sql.NullInt64
+	err := n.Scan(value)
+	d.Duration, d.Valid = time.Duration(n.Int64), n.Valid && err == nil
+	return err
+}
+
+// Value implements driver.Valuer.
+// It returns the number of nanoseconds as an int64, or nil if this Duration is null.
+func (d Duration) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return int64(d.Duration), nil
+}
+
+// SetValid changes this Duration's value and also sets it to be non-null.
+func (d *Duration) SetValid(v time.Duration) {
+	d.Duration = v
+	d.Valid = true
+}
+
+// SetNull sets this Duration to null, resetting its value to zero.
+func (d *Duration) SetNull() {
+	d.Duration = 0
+	d.Valid = false
+}
+
+// Ptr returns a pointer to this Duration's value, or a nil pointer if this Duration is null.
+func (d Duration) Ptr() *time.Duration {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Duration
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Duration) ValueOrZero() time.Duration {
+	if !d.Valid {
+		return 0
+	}
+	return d.Duration
+}
+
+// IsNull returns true if this Duration is null.
+func (d Duration) IsNull() bool {
+	return !d.Valid
+}
+
+// Null is the same as IsNull.
+func (d Duration) Null() bool {
+	return !d.Valid
+}
+
+// IsValid returns true if this Duration is not null.
+func (d Duration) IsValid() bool {
+	return d.Valid
+}
+
+// IsZero returns true for null Durations and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Duration from a valid zero one.
+func (d Duration) IsZero() bool {
+	return !d.Valid || d.Duration == 0
+}
@@ -0,0 +1,193 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var (
+	durationJSON      = []byte(`"500ms"`)
+	durationNanosJSON = []byte(`500000000`)
+	durationValue     = 500 * time.Millisecond
+)
+
+func TestDurationFrom(t *testing.T) {
+	d := DurationFrom(durationValue)
+	assertDuration(t, d, "DurationFrom()")
+
+	zero := DurationFrom(0)
+	if !zero.Valid {
+		t.Error("DurationFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestDurationFromPtr(t *testing.T) {
+	n := durationValue
+	d := DurationFromPtr(&n)
+	assertDuration(t, d, "DurationFromPtr()")
+
+	null := DurationFromPtr(nil)
+	assertNullDuration(t, null, "DurationFromPtr(nil)")
+}
+
+func TestUnmarshalDuration(t *testing.T) {
+	var d Duration
+	err := json.Unmarshal(durationJSON, &d)
+	maybePanic(err)
+	assertDuration(t, d, "duration string json")
+
+	var nanos Duration
+	err = json.Unmarshal(durationNanosJSON, &nanos)
+	maybePanic(err)
+	assertDuration(t, nanos, "duration nanoseconds json")
+
+	var blank Duration
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullDuration(t, blank, "blank string json")
+
+	var null Duration
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDuration(t, null, "null json")
+
+	var invalid Duration
+	err = json.Unmarshal(stringJSON, &invalid)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDuration(t, invalid, "invalid string json")
+
+	var badType Duration
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDuration(t, badType, "wrong type json")
+}
+
+func TestTextUnmarshalDuration(t *testing.T) {
+	var d Duration
+	err := d.UnmarshalText([]byte("500ms"))
+	maybePanic(err)
+	assertDuration(t, d, "UnmarshalText() duration")
+
+	var blank Duration
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullDuration(t, blank, "UnmarshalText() empty duration")
+}
+
+func TestMarshalDuration(t *testing.T) {
+	d := DurationFrom(90 * time.Minute)
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"1h30m0s"`, "non-empty json marshal")
+
+	null := DurationFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, `null`, "null json marshal")
+}
+
+func TestMarshalDurationText(t *testing.T) {
+	d := DurationFrom(durationValue)
+	data, err := d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "500ms", "non-empty text marshal")
+
+	null := DurationFromPtr(nil)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestDurationPointer(t *testing.T) {
+	d := DurationFrom(durationValue)
+	ptr := d.Ptr()
+	if *ptr != durationValue {
+		t.Errorf("bad %s duration: %#v ≠ %v\n", "pointer", ptr, durationValue)
+	}
+
+	null := NewDuration(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s duration: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestDurationIsZero(t *testing.T) {
+	d := DurationFrom(durationValue)
+	if d.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := DurationFromPtr(nil)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := DurationFrom(0)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestDurationValueOrZero(t *testing.T) {
+	valid := DurationFrom(durationValue)
+	if valid.ValueOrZero() != durationValue {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewDuration(durationValue, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestDurationSetValid(t *testing.T) {
+	change := NewDuration(0, false)
+	assertNullDuration(t, change, "SetValid()")
+	change.SetValid(durationValue)
+	assertDuration(t, change, "SetValid()")
+}
+
+func TestDurationScan(t *testing.T) {
+	var d Duration
+	err := d.Scan(int64(durationValue))
+	maybePanic(err)
+	assertDuration(t, d, "scanned duration")
+
+	var null Duration
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullDuration(t, null, "scanned null")
+
+	v, err := d.Value()
+	maybePanic(err)
+	if v != int64(durationValue) {
+		t.Errorf("bad duration value: %v ≠ %v\n", v, int64(durationValue))
+	}
+}
+
+func assertDuration(t *testing.T, d Duration, from string) {
+	if d.Duration != durationValue {
+		t.Errorf("bad %s duration: %v ≠ %v\n", from, d.Duration, durationValue)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDuration(t *testing.T, d Duration, from string) {
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,206 @@
+//go:build go1.18
+
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// EnumValues lists the allowed values of an Enum. Implement it on an empty struct type
+// for each set of values, and use that type as the Enum's type parameter:
+//
+//	type Status struct{}
+//
+//	func (Status) Values() []string { return []string{"active", "suspended"} }
+//
+//	var req struct {
+//		Status null.Enum[Status] `json:"status"`
+//	}
+//
+// Values is called on the zero value of the type, so it must not depend on its receiver.
+//
+// There is no NewEnum(allowed...) constructor taking the values at run time. Code that would
+// pass the allowed values to a constructor declares a type like Status above instead,
+// moving the list into its Values method, and uses null.Enum[Status] for its fields and
+// null.EnumFrom[Status] or null.MustEnum[Status] to create values. Because the set belongs
+// to the type, struct fields need no initialization before decoding into them.
+type EnumValues interface {
+	Values() []string
+}
+
+// Enum is a nullable string restricted to the values listed by S.
+// It supports SQL and JSON serialization, and will marshal to null if null.
+// Decoding or scanning a value outside the set is an error, including into a zero Enum,
+// since the set belongs to the type rather than to each value.
+// Blank string input will be considered null.
+type Enum[S EnumValues] struct {
+	String string
+	Valid  bool
+}
+
+// EnumFrom creates a new valid Enum, or returns an error if s is not an allowed value.
+func EnumFrom[S EnumValues](s string) (Enum[S], error) {
+	var e Enum[S]
+	err := e.Set(s)
+	return e, err
+}
+
+// MustEnum is like EnumFrom but panics if s is not an allowed value.
+// It is intended for test fixtures and package-level variables.
+func MustEnum[S EnumValues](s string) Enum[S] {
+	e, err := EnumFrom[S](s)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Set changes this Enum's value and sets it to be non-null.
+// It returns an error, leaving the Enum unchanged, if s is not an allowed value.
+func (e *Enum[S]) Set(s string) error {
+	if err := e.check(s); err != nil {
+		return err
+	}
+	e.String = s
+	e.Valid = true
+	return nil
+}
+
+// SetNull sets this Enum to null, resetting its value to zero.
+func (e *Enum[S]) SetNull() {
+	e.String = ""
+	e.Valid = false
+}
+
+// Allowed returns the values this Enum accepts, in the order S lists them.
+func (e Enum[S]) Allowed() []string {
+	var set S
+	return append([]string(nil), set.Values()...)
+}
+
+func (e Enum[S]) check(s string) error {
+	var set S
+	allowed := set.Values()
+	for _, v := range allowed {
+		if v == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("null: %q is not an allowed %T value (allowed: %q)", s, e, allowed)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input. Blank string input produces a null Enum.
+// It returns an error if the input is not an allowed value.
+func (e *Enum[S]) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, fmt.Sprintf("%T", *e))
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		return e.UnmarshalText([]byte(x))
+	case nil:
+		e.Valid = false
+		return nil
+	}
+	e.Valid = false
+	return fmt.Errorf("json: cannot unmarshal %v into Go value of type %T", reflect.TypeOf(v).Name(), *e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Enum if the input is blank or matches NullText.
+// It returns an error if the input is not an allowed value.
+func (e *Enum[S]) UnmarshalText(text []byte) error {
+	if isNullText(text) {
+		e.Valid = false
+		return nil
+	}
+	if err := e.Set(string(text)); err != nil {
+		e.Valid = false
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Enum is null.
+func (e Enum[S]) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(e.String)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Enum is null.
+func (e Enum[S]) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return nullText(), nil
+	}
+	return []byte(e.String), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports string and []byte values, and returns an error if the value is not allowed.
+func (e *Enum[S]) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case string:
+		return e.UnmarshalText([]byte(x))
+	case []byte:
+		return e.UnmarshalText(x)
+	case nil:
+		e.Valid = false
+		return nil
+	}
+	e.Valid = false
+	return fmt.Errorf("null: cannot scan type %T into %T: %v", value, *e, value)
+}
+
+// Value implements driver.Valuer.
+// It returns the string value, or nil if this Enum is null.
+func (e Enum[S]) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	return e.String, nil
+}
+
+// Ptr returns a pointer to this Enum's value, or a nil pointer if this Enum is null.
+func (e Enum[S]) Ptr() *string {
+	if !e.Valid {
+		return nil
+	}
+	return &e.String
+}
+
+// ValueOrZero returns the inner value if valid, otherwise a blank string.
+func (e Enum[S]) ValueOrZero() string {
+	if !e.Valid {
+		return ""
+	}
+	return e.String
+}
+
+// IsNull returns true if this Enum is null.
+func (e Enum[S]) IsNull() bool {
+	return !e.Valid
+}
+
+// Null is the same as IsNull.
+func (e Enum[S]) Null() bool {
+	return !e.Valid
+}
+
+// IsValid returns true if this Enum is not null.
+func (e Enum[S]) IsValid() bool {
+	return e.Valid
+}
+
+// IsZero returns true for null Enums and for valid ones holding a blank string, for omitempty support.
+// Use IsValid to tell a null Enum from a valid zero one.
+func (e Enum[S]) IsZero() bool {
+	return !e.Valid || e.String == ""
+}
@@ -0,0 +1,211 @@
+//go:build go1.18
+
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type testStatus struct{}
+
+func (testStatus) Values() []string { return []string{"active", "suspended", "deleted"} }
+
+type statusEnum = Enum[testStatus]
+
+var _ Nullable = (*statusEnum)(nil)
+
+func TestEnumFrom(t *testing.T) {
+	e, err := EnumFrom[testStatus]("active")
+	maybePanic(err)
+	assertEnum(t, e, "active", "EnumFrom()")
+
+	invalid, err := EnumFrom[testStatus]("archived")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullEnum(t, invalid, "EnumFrom() unknown value")
+}
+
+func TestMustEnum(t *testing.T) {
+	assertEnum(t, MustEnum[testStatus]("deleted"), "deleted", "MustEnum()")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustEnum() unknown value should panic")
+		}
+	}()
+	MustEnum[testStatus]("archived")
+}
+
+func TestUnmarshalEnum(t *testing.T) {
+	var user struct {
+		Status statusEnum `json:"status"`
+	}
+	err := json.Unmarshal([]byte(`{"status":"suspended"}`), &user)
+	maybePanic(err)
+	assertEnum(t, user.Status, "suspended", "enum json")
+
+	var req struct {
+		Status statusEnum `json:"status"`
+	}
+	err = json.Unmarshal([]byte(`{"status":"archived"}`), &req)
+	if err == nil {
+		t.Error("expected error decoding an unknown value into a zero struct field")
+	}
+	assertNullEnum(t, req.Status, "unknown value in zero struct field")
+
+	var unknown statusEnum
+	err = json.Unmarshal([]byte(`"archived"`), &unknown)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullEnum(t, unknown, "unknown value json")
+
+	var null statusEnum
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullEnum(t, null, "null json")
+
+	var blank statusEnum
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullEnum(t, blank, "blank string json")
+
+	var badType statusEnum
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullEnum(t, badType, "wrong type json")
+}
+
+func TestMarshalEnum(t *testing.T) {
+	e := MustEnum[testStatus]("deleted")
+	data, err := json.Marshal(e)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"deleted"`, "non-empty json marshal")
+
+	data, err = e.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "deleted", "non-empty text marshal")
+
+	var null statusEnum
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestEnumScan(t *testing.T) {
+	var s statusEnum
+	err := s.Scan("active")
+	maybePanic(err)
+	assertEnum(t, s, "active", "scanned string")
+
+	var b statusEnum
+	err = b.Scan([]byte("deleted"))
+	maybePanic(err)
+	assertEnum(t, b, "deleted", "scanned []byte")
+
+	var null statusEnum
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullEnum(t, null, "scanned null")
+
+	var unknown statusEnum
+	err = unknown.Scan("archived")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullEnum(t, unknown, "scanned unknown value")
+
+	var badType statusEnum
+	err = badType.Scan(int64(1))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullEnum(t, badType, "scanned int64")
+
+	v, err := s.Value()
+	maybePanic(err)
+	if v != "active" {
+		t.Errorf("bad enum value: %v ≠ %v\n", v, "active")
+	}
+
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null enum value: %v ≠ nil\n", v)
+	}
+}
+
+func TestEnumSet(t *testing.T) {
+	var e statusEnum
+	err := e.Set("active")
+	maybePanic(err)
+	assertEnum(t, e, "active", "Set()")
+
+	err = e.Set("archived")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertEnum(t, e, "active", "Set() unknown value")
+
+	e.SetNull()
+	assertNullEnum(t, e, "SetNull()")
+	if e != (statusEnum{}) {
+		t.Errorf("SetNull() should reset to zero, got %#v", e)
+	}
+
+	vals := e.Allowed()
+	if len(vals) != 3 || vals[0] != "active" {
+		t.Errorf("bad Allowed(): %v", vals)
+	}
+	vals[0] = "changed"
+	if e.Allowed()[0] != "active" {
+		t.Error("modifying Allowed()'s result changed the allowed values")
+	}
+}
+
+func TestEnumValueOrZero(t *testing.T) {
+	valid := MustEnum[testStatus]("active")
+	if valid.ValueOrZero() != "active" {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+	if *valid.Ptr() != "active" {
+		t.Error("unexpected Ptr", valid.Ptr())
+	}
+	if valid.IsNull() || valid.Null() || !valid.IsValid() || valid.IsZero() {
+		t.Error("unexpected IsNull, Null, IsValid or IsZero for a valid Enum")
+	}
+
+	var invalid statusEnum
+	if invalid.ValueOrZero() != "" {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+	if invalid.Ptr() != nil {
+		t.Error("unexpected Ptr", invalid.Ptr())
+	}
+	if !invalid.IsNull() || !invalid.Null() || invalid.IsValid() || !invalid.IsZero() {
+		t.Error("unexpected IsNull, Null, IsValid or IsZero for a null Enum")
+	}
+}
+
+func assertEnum(t *testing.T, e statusEnum, expected string, from string) {
+	if e.String != expected {
+		t.Errorf("bad %s enum: %s ≠ %s\n", from, e.String, expected)
+	}
+	if !e.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullEnum(t *testing.T, e statusEnum, from string) {
+	if e.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
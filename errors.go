@@ -0,0 +1,54 @@
+package null
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// ErrRequired is the underlying error when UnmarshalJSONStrict rejects input
+// that would produce a null value.
+var ErrRequired = errors.New("value is required")
+
+// UnmarshalError is returned when JSON input can't be decoded into one of this package's types,
+// by UnmarshalJSON and its Strict and Validate variants.
+// Use errors.As to find which type and input failed, and errors.Is or Unwrap
+// to get at the underlying error, such as a *strconv.NumError or a validation error.
+type UnmarshalError struct {
+	Type  string // the type being decoded into, such as "null.Int"
+	Value string // the JSON input, without surrounding whitespace
+	Err   error  // the underlying error
+}
+
+// Error implements the error interface. Long input is shortened in the message,
+// but Value always holds all of it.
+func (e *UnmarshalError) Error() string {
+	v := e.Value
+	if len(v) > 64 {
+		v = v[:61] + "..."
+	}
+	return fmt.Sprintf("null: cannot unmarshal %s into %s: %v", v, e.Type, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *UnmarshalError) Unwrap() error {
+	return e.Err
+}
+
+// unmarshalError wraps a decoding error for data into typ in an UnmarshalError.
+// It returns nil if err is nil, and err as is if it is already an UnmarshalError,
+// so types that decode through another type's UnmarshalJSON aren't wrapped twice.
+func unmarshalError(data []byte, typ string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*UnmarshalError); ok {
+		return err
+	}
+	return &UnmarshalError{Type: typ, Value: string(bytes.TrimSpace(data)), Err: err}
+}
+
+// wrapUnmarshalError is deferred by UnmarshalJSON methods to pass their result through unmarshalError.
+func wrapUnmarshalError(err *error, data []byte, typ string) {
+	*err = unmarshalError(data, typ, *err)
+}
@@ -0,0 +1,97 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUnmarshalError(t *testing.T) {
+	var i Int
+	err := json.Unmarshal([]byte(`9223372036854775808`), &i)
+	var ue *UnmarshalError
+	if !errors.As(err, &ue) {
+		t.Fatalf("expected *UnmarshalError, got %T: %v", err, err)
+	}
+	if ue.Type != "null.Int" || ue.Value != "9223372036854775808" {
+		t.Errorf("bad UnmarshalError fields: %+v", ue)
+	}
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("UnmarshalError should unwrap to strconv.ErrRange: %v", err)
+	}
+
+	var ti Time
+	err = ti.UnmarshalJSON([]byte(` "hello world" `))
+	if !errors.As(err, &ue) {
+		t.Fatalf("expected *UnmarshalError, got %T: %v", err, err)
+	}
+	if ue.Type != "null.Time" || ue.Value != `"hello world"` {
+		t.Errorf("bad UnmarshalError fields: %+v", ue)
+	}
+	var parseErr *time.ParseError
+	if !errors.As(ue.Unwrap(), &parseErr) {
+		t.Errorf("UnmarshalError should unwrap to *time.ParseError, got %T", ue.Unwrap())
+	}
+	if want := `null: cannot unmarshal "hello world" into null.Time: `; !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("bad error message: %q, want prefix %q", err.Error(), want)
+	}
+
+	var s Slice[int]
+	err = s.UnmarshalJSON([]byte(`["x"]`))
+	if !errors.As(err, &ue) || ue.Type != "null.Slice[int]" {
+		t.Errorf("bad generic UnmarshalError: %v", err)
+	}
+
+	long := `"` + strings.Repeat("x", 100) + `"`
+	err = i.UnmarshalJSON([]byte(long))
+	if !errors.As(err, &ue) || ue.Value != long {
+		t.Errorf("UnmarshalError should keep the full input: %v", err)
+	}
+	if strings.Contains(err.Error(), long) || !strings.Contains(err.Error(), "...") {
+		t.Errorf("long input should be shortened in the message: %q", err.Error())
+	}
+}
+
+func TestUnmarshalErrorEveryType(t *testing.T) {
+	inputs := []string{`true`, `"x"`, `{}`, `[]`, `1.5`, `-1`}
+	for _, v := range validValues() {
+		ptr := reflect.New(reflect.TypeOf(v))
+		u, ok := ptr.Interface().(json.Unmarshaler)
+		if !ok {
+			continue
+		}
+		for _, in := range inputs {
+			err := u.UnmarshalJSON([]byte(in))
+			if err == nil {
+				continue
+			}
+			var ue *UnmarshalError
+			if !errors.As(err, &ue) {
+				t.Errorf("%T.UnmarshalJSON(%s) returned %T, want *UnmarshalError: %v", v, in, err, err)
+				continue
+			}
+			if errors.As(ue.Err, new(*UnmarshalError)) {
+				t.Errorf("%T.UnmarshalJSON(%s) wrapped an UnmarshalError twice: %v", v, in, err)
+			}
+			if ue.Value != in {
+				t.Errorf("%T.UnmarshalJSON(%s) error has Value %q", v, in, ue.Value)
+			}
+		}
+	}
+}
+
+func TestStrictError(t *testing.T) {
+	var s String
+	err := s.UnmarshalJSONStrict(nullJSON)
+	var ue *UnmarshalError
+	if !errors.As(err, &ue) || ue.Type != "null.String" || ue.Value != "null" {
+		t.Errorf("bad strict error: %v", err)
+	}
+	if !errors.Is(err, ErrRequired) {
+		t.Errorf("strict error should unwrap to ErrRequired: %v", err)
+	}
+}
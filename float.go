@@ -1,13 +1,27 @@
 package null
 
 import (
+	"bytes"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"reflect"
+	"math"
 	"strconv"
+	"strings"
 )
 
+// NaNToNull makes Float.MarshalJSON encode NaN and ±Inf as null.
+// By default they are an error, since JSON has no way to represent them.
+var NaNToNull = false
+
+// FloatPrecision is the number of digits after the decimal point that Float writes
+// when marshaling to JSON and text. Floats never use exponent notation, so 1e-7 is 0.0000001.
+// The default, -1, writes the fewest digits that decode back to the exact same float64.
+// Any other value rounds to that many digits, so decoding the output may not give back
+// the original value. Like NaNToNull, it applies to every Float in the process.
+var FloatPrecision = -1
+
 // Float is a nullable float64.
 // It does not consider zero values to be null.
 // It will decode to null, not zero, if null.
@@ -38,35 +52,106 @@ func FloatFromPtr(f *float64) Float {
 	return NewFloat(*f, true)
 }
 
+// FloatFromSQL creates a new Float with the same value and validity as f.
+// The embedded NullFloat64 field converts back the other way.
+func FloatFromSQL(f sql.NullFloat64) Float {
+	return Float{NullFloat64: f}
+}
+
+// FloatFromString creates a new Float by parsing s.
+// It will be null if s is blank, and an error is returned if s is not a number.
+func FloatFromString(s string) (Float, error) {
+	if s == "" {
+		return NewFloat(0, false), nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	return NewFloat(f, err == nil), err
+}
+
+// MustFloat is like FloatFromString but panics if s is not a number.
+// It is intended for test fixtures and package-level variables.
+func MustFloat(s string) Float {
+	f, err := FloatFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports number and null input.
+// It supports number and null input, and numbers quoted as strings, such as "3.14"; a blank string produces a null Float.
 // 0 will not be considered a null Float.
-// It also supports unmarshalling a sql.NullFloat64.
-func (f *Float) UnmarshalJSON(data []byte) error {
-	var err error
-	var v interface{}
-	json.Unmarshal(data, &v)
-	switch x := v.(type) {
-	case float64:
-		f.Float64 = float64(x)
-	case map[string]interface{}:
+// JSON has no NaN or Inf literals, so those can't be decoded; numbers too large for a float64 are an error.
+// It also supports unmarshalling a sql.NullFloat64, which is null unless its Valid field is true.
+func (f *Float) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Float")
+	switch data = bytes.TrimSpace(data); kindOf(data) {
+	case jsonNumber:
+		f.Float64, err = strconv.ParseFloat(string(data), 64)
+		if err != nil {
+			err = fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Float: %w", data, err)
+		}
+	case jsonString:
+		var str string
+		if str, err = unquoteNumber(data, "null.Float"); err != nil {
+			break
+		}
+		if str == "" {
+			f.Valid = false
+			return nil
+		}
+		f.Float64, err = strconv.ParseFloat(str, 64)
+		if err != nil {
+			err = fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Float: %w", data, err)
+		}
+	case jsonObject:
+		f.NullFloat64 = sql.NullFloat64{}
 		err = json.Unmarshal(data, &f.NullFloat64)
-	case nil:
+		f.Valid = err == nil && f.Valid
+		return err
+	case jsonNull:
 		f.Valid = false
 		return nil
 	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Float", reflect.TypeOf(v).Name())
+		err = jsonTypeError(data, "null.Float")
 	}
 	f.Valid = err == nil
 	return err
 }
 
+// UnmarshalJSONStrict is like UnmarshalJSON, but returns an error for input that
+// would produce a null Float, such as null.
+// Call it from a custom UnmarshalJSON to reject missing values for required fields.
+func (f *Float) UnmarshalJSONStrict(data []byte) error {
+	if err := f.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	if !f.Valid {
+		return strictNullError(data, "null.Float")
+	}
+	return nil
+}
+
+// UnmarshalJSONValidate is like UnmarshalJSON, but also calls validate with the decoded value.
+// If validate returns an error, this Float is set to null and the error is returned wrapped.
+// Input that produces a null Float is not validated.
+func (f *Float) UnmarshalJSONValidate(data []byte, validate func(float64) error) error {
+	if err := f.UnmarshalJSON(data); err != nil || !f.Valid {
+		return err
+	}
+	if err := validate(f.Float64); err != nil {
+		f.Valid = false
+		return validateError(data, "null.Float", err)
+	}
+	return nil
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Float if the input is a blank or not an integer.
-// It will return an error if the input is not an integer, blank, or "null".
+// It will unmarshal to a null Float if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not a number, blank, or "null".
 func (f *Float) UnmarshalText(text []byte) error {
 	str := string(text)
-	if str == "" || str == "null" {
+	if str == "null" || isNullText(text) {
 		f.Valid = false
 		return nil
 	}
@@ -77,21 +162,71 @@ func (f *Float) UnmarshalText(text []byte) error {
 }
 
 // MarshalJSON implements json.Marshaler.
-// It will encode null if this Float is null.
+// It will encode null if this Float is null, or its zero value if NullZero is set.
+// NaN and ±Inf are an error, or null if NaNToNull is set.
+// Numbers are written without an exponent, with FloatPrecision digits after the decimal point.
 func (f Float) MarshalJSON() ([]byte, error) {
+	b, err := f.AppendJSON(make([]byte, 0, 24))
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// AppendJSON appends the JSON encoding of this Float to dst, as MarshalJSON would encode it.
+// Encoders can reuse dst across calls to avoid allocating for every value.
+// On error, dst is returned unchanged.
+func (f Float) AppendJSON(dst []byte) ([]byte, error) {
 	if !f.Valid {
-		return []byte("null"), nil
+		if NullZero {
+			return append(dst, '0'), nil
+		}
+		return append(dst, "null"...), nil
+	}
+	if math.IsNaN(f.Float64) || math.IsInf(f.Float64, 0) {
+		if NaNToNull {
+			return append(dst, "null"...), nil
+		}
+		return dst, fmt.Errorf("null: cannot marshal %v into JSON: NaN and Inf are not valid JSON numbers", f.Float64)
 	}
-	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+	return strconv.AppendFloat(dst, f.Float64, 'f', FloatPrecision, 64), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It will encode a blank string if this Float is null.
+// It will encode NullText, blank by default, if this Float is null, and otherwise formats like MarshalJSON.
 func (f Float) MarshalText() ([]byte, error) {
 	if !f.Valid {
-		return []byte{}, nil
+		return nullText(), nil
 	}
-	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+	return []byte(strconv.FormatFloat(f.Float64, 'f', FloatPrecision, 64)), nil
+}
+
+// Scan implements sql.Scanner.
+// In addition to the values sql.NullFloat64 accepts, it parses string and []byte values
+// with strconv.ParseFloat after trimming surrounding whitespace, so underscores
+// between digits are accepted as in "1_000.5".
+// Values of named types, such as `type MyFloat float64`, are scanned as their underlying type.
+func (f *Float) Scan(value interface{}) error {
+	var s string
+	switch v := driverValue(value).(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return f.NullFloat64.Scan(v)
+	}
+	f.Float64, f.Valid = 0, false
+	s = strings.TrimSpace(s)
+	n, err := strconv.ParseFloat(s, 64)
+	switch {
+	case errors.Is(err, strconv.ErrRange):
+		return fmt.Errorf("null: cannot scan %q into null.Float: out of range", s)
+	case err != nil:
+		return fmt.Errorf("null: cannot scan %q into null.Float: not a number", s)
+	}
+	f.Float64, f.Valid = n, true
+	return nil
 }
 
 // SetValid changes this Float's value and also sets it to be non-null.
@@ -100,6 +235,30 @@ func (f *Float) SetValid(n float64) {
 	f.Valid = true
 }
 
+// Set changes this Float's value and validity at once.
+// Unlike SetValid, it can keep a value while marking this Float null.
+func (f *Float) Set(v float64, valid bool) {
+	f.Float64 = v
+	f.Valid = valid
+}
+
+// SetNull sets this Float to null, resetting its value to zero
+// so that stale data doesn't show through the Float64 field.
+func (f *Float) SetNull() {
+	f.Float64 = 0
+	f.Valid = false
+}
+
+// SetValidIf sets this Float to v if cond is true, and to null otherwise.
+// Unlike Set, it resets the value to zero when cond is false.
+func (f *Float) SetValidIf(v float64, cond bool) {
+	if !cond {
+		f.SetNull()
+		return
+	}
+	f.SetValid(v)
+}
+
 // Ptr returns a pointer to this Float's value, or a nil pointer if this Float is null.
 func (f Float) Ptr() *float64 {
 	if !f.Valid {
@@ -108,8 +267,128 @@ func (f Float) Ptr() *float64 {
 	return &f.Float64
 }
 
-// IsZero returns true for invalid Floats, for future omitempty support (Go 1.4?)
-// A non-null Float with a 0 value will not be considered zero.
-func (f Float) IsZero() bool {
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (f Float) ValueOrZero() float64 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Float64
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (f Float) ValueOr(def float64) float64 {
+	if !f.Valid {
+		return def
+	}
+	return f.Float64
+}
+
+// Equal returns true if both floats have the same value or are both null.
+func (f Float) Equal(other Float) bool {
+	return f.Valid == other.Valid && (!f.Valid || f.Float64 == other.Float64)
+}
+
+// EqualTol returns true if both floats are null, or both are valid and differ by at most eps,
+// for comparing values that went through rounding such as a decimal encoding.
+// Equal infinities are equal, and NaN is never equal to anything.
+func (f Float) EqualTol(other Float, eps float64) bool {
+	if !f.Valid || !other.Valid {
+		return f.Valid == other.Valid
+	}
+	return f.Float64 == other.Float64 || math.Abs(f.Float64-other.Float64) <= eps
+}
+
+// Compare returns -1 if this Float sorts before other, 1 if it sorts after, and 0 if they are equal,
+// for use with sort and slices.SortFunc. Valid values are ordered numerically, with NaN before every other number as in cmp.Compare.
+// Null values are equal to each other and sort before every valid value, or after if NullsLast is set.
+func (f Float) Compare(other Float) int {
+	if c, ok := compareNull(f.Valid, other.Valid); ok {
+		return c
+	}
+	x, y := f.Float64, other.Float64
+	xNaN, yNaN := math.IsNaN(x), math.IsNaN(y)
+	switch {
+	case x < y || (xNaN && !yNaN):
+		return -1
+	case x > y || (!xNaN && yNaN):
+		return 1
+	}
+	return 0
+}
+
+// Changed returns true if this Float differs from old: if one is null and the other isn't,
+// or if both are valid with different values. It is the inverse of Equal.
+func (f Float) Changed(old Float) bool {
+	return !f.Equal(old)
+}
+
+// Or returns this Float if it is valid, otherwise other.
+func (f Float) Or(other Float) Float {
+	if f.Valid {
+		return f
+	}
+	return other
+}
+
+// Merge copies patch into this Float if patch is valid, and otherwise leaves it unchanged,
+// for applying partial updates where a null field means "keep the current value".
+func (f *Float) Merge(patch Float) {
+	if patch.Valid {
+		*f = patch
+	}
+}
+
+// Add returns f+g, or a null Float if either operand is null, as with NULL in SQL.
+func (f Float) Add(g Float) Float {
+	if !f.Valid || !g.Valid {
+		return Float{}
+	}
+	return FloatFrom(f.Float64 + g.Float64)
+}
+
+// Sub returns f-g, or a null Float if either operand is null, as with NULL in SQL.
+func (f Float) Sub(g Float) Float {
+	if !f.Valid || !g.Valid {
+		return Float{}
+	}
+	return FloatFrom(f.Float64 - g.Float64)
+}
+
+// Map returns a valid Float holding fn applied to this Float's value,
+// or this Float unchanged if it is null. fn is not called for a null Float.
+func (f Float) Map(fn func(float64) float64) Float {
+	if !f.Valid {
+		return f
+	}
+	return FloatFrom(fn(f.Float64))
+}
+
+// String implements fmt.Stringer.
+// It returns this Float's value, or NullDisplay if null.
+func (f Float) String() string {
+	if !f.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatFloat(f.Float64, 'f', -1, 64)
+}
+
+// IsNull returns true if this Float is null.
+func (f Float) IsNull() bool {
+	return !f.Valid
+}
+
+// Null is the same as IsNull.
+func (f Float) Null() bool {
 	return !f.Valid
 }
+
+// IsValid returns true if this Float is not null.
+func (f Float) IsValid() bool {
+	return f.Valid
+}
+
+// IsZero returns true for null Floats and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Float from a valid zero one.
+func (f Float) IsZero() bool {
+	return !f.Valid || f.Float64 == 0
+}
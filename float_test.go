@@ -1,7 +1,12 @@
 package null
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -30,6 +35,42 @@ func TestFloatFromPtr(t *testing.T) {
 	assertNullFloat(t, null, "FloatFromPtr(nil)")
 }
 
+func TestFloatFromSQL(t *testing.T) {
+	v := FloatFromSQL(sql.NullFloat64{Float64: 1.2345, Valid: true})
+	assertFloat(t, v, "FloatFromSQL()")
+	if v.NullFloat64 != (sql.NullFloat64{Float64: 1.2345, Valid: true}) {
+		t.Errorf("bad NullFloat64 round trip: %v", v.NullFloat64)
+	}
+
+	null := FloatFromSQL(sql.NullFloat64{})
+	assertNullFloat(t, null, "FloatFromSQL() null")
+	if null.NullFloat64 != (sql.NullFloat64{}) {
+		t.Errorf("bad null NullFloat64 round trip: %v", null.NullFloat64)
+	}
+}
+
+func TestFloatFromString(t *testing.T) {
+	f, err := FloatFromString("1.2345")
+	maybePanic(err)
+	assertFloat(t, f, "FloatFromString()")
+
+	null, err := FloatFromString("")
+	maybePanic(err)
+	assertNullFloat(t, null, "FloatFromString(\"\")")
+
+	invalid, err := FloatFromString("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullFloat(t, invalid, "FloatFromString() invalid")
+}
+
+func TestMustFloat(t *testing.T) {
+	assertFloat(t, MustFloat("1.2345"), "MustFloat()")
+	assertNullFloat(t, MustFloat(""), "MustFloat(\"\")")
+	assertPanics(t, func() { MustFloat("hello") }, "MustFloat() invalid")
+}
+
 func TestUnmarshalFloat(t *testing.T) {
 	var f Float
 	err := json.Unmarshal(floatJSON, &f)
@@ -41,6 +82,11 @@ func TestUnmarshalFloat(t *testing.T) {
 	maybePanic(err)
 	assertFloat(t, nf, "sq.NullFloat64 json")
 
+	invalidObj := FloatFrom(1.2345)
+	err = json.Unmarshal([]byte(`{"Float64":1.2345,"Valid":false}`), &invalidObj)
+	maybePanic(err)
+	assertNullFloat(t, invalidObj, "sql.NullFloat64 json with Valid false")
+
 	var null Float
 	err = json.Unmarshal(nullJSON, &null)
 	maybePanic(err)
@@ -54,6 +100,83 @@ func TestUnmarshalFloat(t *testing.T) {
 	assertNullFloat(t, badType, "wrong type json")
 }
 
+func TestFloatUnmarshalJSONStrict(t *testing.T) {
+	var v Float
+	err := v.UnmarshalJSONStrict(floatJSON)
+	maybePanic(err)
+	assertFloat(t, v, "strict json")
+
+	var null Float
+	if err := null.UnmarshalJSONStrict(nullJSON); err == nil {
+		t.Error("expected error")
+	}
+	assertNullFloat(t, null, "strict null json")
+}
+
+func TestFloatUnmarshalJSONValidate(t *testing.T) {
+	validate := func(v float64) error {
+		if v > 1.2345 {
+			return errTooBig
+		}
+		return nil
+	}
+
+	var v Float
+	err := v.UnmarshalJSONValidate(floatJSON, validate)
+	assertValidated(t, err, nil, "validated json")
+	assertFloat(t, v, "validated json")
+
+	var invalid Float
+	err = invalid.UnmarshalJSONValidate([]byte(`2`), validate)
+	assertValidated(t, err, errTooBig, "invalid json")
+	assertNullFloat(t, invalid, "invalid json")
+
+	var null Float
+	err = null.UnmarshalJSONValidate(nullJSON, func(float64) error { return errTooBig })
+	assertValidated(t, err, nil, "null json")
+	assertNullFloat(t, null, "null json")
+}
+
+func TestUnmarshalFloatToken(t *testing.T) {
+	for _, in := range []string{"1.2345", "12345e-4", " 1.2345 "} {
+		var f Float
+		err := f.UnmarshalJSON([]byte(in))
+		maybePanic(err)
+		assertFloat(t, f, "float token "+in)
+	}
+
+	var invalid Float
+	err := invalid.UnmarshalJSON([]byte(`1.2.3`))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullFloat(t, invalid, "malformed float token")
+}
+
+func TestMarshalFloatNaNInf(t *testing.T) {
+	defer func(old bool) { NaNToNull = old }(NaNToNull)
+	values := []float64{math.NaN(), math.Inf(1), math.Inf(-1)}
+
+	NaNToNull = false
+	for _, v := range values {
+		_, err := json.Marshal(FloatFrom(v))
+		if err == nil || !strings.Contains(err.Error(), "NaN and Inf") {
+			t.Errorf("expected a clear error marshaling %v, got %v", v, err)
+		}
+	}
+
+	NaNToNull = true
+	for _, v := range values {
+		data, err := json.Marshal(FloatFrom(v))
+		maybePanic(err)
+		assertJSONEquals(t, data, "null", "NaNToNull json marshal")
+	}
+
+	data, err := json.Marshal(FloatFrom(1.2345))
+	maybePanic(err)
+	assertJSONEquals(t, data, "1.2345", "NaNToNull finite json marshal")
+}
+
 func TestTextUnmarshalFloat(t *testing.T) {
 	var f Float
 	err := f.UnmarshalText([]byte("1.2345"))
@@ -71,6 +194,36 @@ func TestTextUnmarshalFloat(t *testing.T) {
 	assertNullFloat(t, null, `UnmarshalText() "null"`)
 }
 
+func TestUnmarshalFloatQuoted(t *testing.T) {
+	var quoted Float
+	err := json.Unmarshal([]byte(`"1.2345"`), &quoted)
+	maybePanic(err)
+	assertFloat(t, quoted, "quoted float json")
+
+	var bare Float
+	err = json.Unmarshal(floatJSON, &bare)
+	maybePanic(err)
+	assertFloat(t, bare, "bare float json")
+
+	var exp Float
+	err = json.Unmarshal([]byte(`"12345e-4"`), &exp)
+	maybePanic(err)
+	assertFloat(t, exp, "quoted exponent json")
+
+	blank := FloatFrom(1.2345)
+	err = json.Unmarshal([]byte(`""`), &blank)
+	maybePanic(err)
+	assertNullFloat(t, blank, "blank string json")
+
+	for _, in := range []string{`"test"`, `"NaN"`, `"Inf"`, `"+1"`, `"1_000"`, `"1e999"`} {
+		bad := FloatFrom(1.2345)
+		if err := json.Unmarshal([]byte(in), &bad); err == nil {
+			t.Errorf("expected error for %s", in)
+		}
+		assertNullFloat(t, bad, "bad quoted json "+in)
+	}
+}
+
 func TestMarshalFloat(t *testing.T) {
 	f := FloatFrom(1.2345)
 	data, err := json.Marshal(f)
@@ -121,10 +274,170 @@ func TestFloatIsZero(t *testing.T) {
 	if !null.IsZero() {
 		t.Errorf("IsZero() should be true")
 	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
 
 	zero := NewFloat(0, true)
-	if zero.IsZero() {
-		t.Errorf("IsZero() should be false")
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestFloatValueOrZero(t *testing.T) {
+	valid := FloatFrom(1.2345)
+	if valid.ValueOrZero() != 1.2345 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewFloat(1.2345, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestFloatCompare(t *testing.T) {
+	nan := FloatFrom(math.NaN())
+	for _, tc := range []struct {
+		a, b       Float
+		want, last int
+	}{
+		{FloatFrom(-1.5), FloatFrom(1.5), -1, -1},
+		{FloatFrom(1.5), FloatFrom(-1.5), 1, 1},
+		{FloatFrom(1.5), FloatFrom(1.5), 0, 0},
+		{nan, FloatFrom(math.Inf(-1)), -1, -1},
+		{FloatFrom(0), nan, 1, 1},
+		{nan, nan, 0, 0},
+		{NewFloat(0, false), nan, -1, 1},
+		{FloatFrom(0), NewFloat(0, false), 1, -1},
+	} {
+		if got := tc.a.Compare(tc.b); got != tc.want {
+			t.Errorf("Compare(%v, %v) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+		NullsLast = true
+		if got := tc.a.Compare(tc.b); got != tc.last {
+			t.Errorf("Compare(%v, %v) with NullsLast = %d, want %d", tc.a, tc.b, got, tc.last)
+		}
+		NullsLast = false
+	}
+}
+
+func TestFloatEqual(t *testing.T) {
+	a := NewFloat(1.2345, false)
+	b := NewFloat(1.2345, false)
+	assertFloatEqualIsTrue(t, a, b)
+
+	a = NewFloat(1.2345, false)
+	b = NewFloat(5.4321, false)
+	assertFloatEqualIsTrue(t, a, b)
+
+	a = NewFloat(1.2345, true)
+	b = NewFloat(1.2345, true)
+	assertFloatEqualIsTrue(t, a, b)
+
+	a = NewFloat(1.2345, true)
+	b = NewFloat(1.2345, false)
+	assertFloatEqualIsFalse(t, a, b)
+
+	a = NewFloat(1.2345, true)
+	b = NewFloat(5.4321, true)
+	assertFloatEqualIsFalse(t, a, b)
+}
+
+func TestFloatEqualTol(t *testing.T) {
+	tenth := 0.1 // a variable, so 0.1 + 0.2 is computed in float64 rather than exactly
+	for _, tc := range []struct {
+		a, b Float
+		eps  float64
+		want bool
+	}{
+		{FloatFrom(tenth + 0.2), FloatFrom(0.3), 1e-9, true},
+		{FloatFrom(1.2345), FloatFrom(1.2346), 1e-3, true},
+		{FloatFrom(1.2345), FloatFrom(1.2445), 1e-3, false},
+		{FloatFrom(1.2345), FloatFrom(1.2345), 0, true},
+		{FloatFrom(math.Inf(1)), FloatFrom(math.Inf(1)), 0, true},
+		{FloatFrom(math.Inf(1)), FloatFrom(math.Inf(-1)), math.MaxFloat64, false},
+		{FloatFrom(math.NaN()), FloatFrom(math.NaN()), 1, false},
+		{NewFloat(1.2345, false), NewFloat(5.4321, false), 0, true},
+		{NewFloat(1.2345, true), NewFloat(1.2345, false), 1, false},
+		{NewFloat(1.2345, false), NewFloat(1.2345, true), 1, false},
+	} {
+		if got := tc.a.EqualTol(tc.b, tc.eps); got != tc.want {
+			t.Errorf("%v.EqualTol(%v, %g) = %v, want %v", tc.a, tc.b, tc.eps, got, tc.want)
+		}
+	}
+	if FloatFrom(tenth + 0.2).Equal(FloatFrom(0.3)) {
+		t.Error("Equal should stay exact")
+	}
+}
+
+func TestFloatValueOr(t *testing.T) {
+	if v := FloatFrom(1.2345).ValueOr(0.5); v != 1.2345 {
+		t.Error("unexpected ValueOr", v)
+	}
+	if v := FloatFrom(0).ValueOr(0.5); v != 0 {
+		t.Error("unexpected ValueOr for valid zero Float", v)
+	}
+	if v := NewFloat(1.2345, false).ValueOr(0.5); v != 0.5 {
+		t.Error("unexpected ValueOr", v)
+	}
+}
+
+func TestFloatOr(t *testing.T) {
+	valid := FloatFrom(1.2345)
+	null := NewFloat(1, false)
+	assertFloat(t, valid.Or(FloatFrom(1)), "valid.Or()")
+	assertFloat(t, null.Or(valid), "null.Or(valid)")
+	assertNullFloat(t, null.Or(null), "null.Or(null)")
+	assertFloat(t, null.Or(null).Or(valid).Or(FloatFrom(1)), "chained Or()")
+}
+
+func TestFloatArithmetic(t *testing.T) {
+	a, b, null := FloatFrom(1), FloatFrom(0.25), NewFloat(0, false)
+	if got := a.Add(b); got != FloatFrom(1.25) {
+		t.Errorf("bad Add(): %v", got)
+	}
+	if got := a.Sub(b); got != FloatFrom(0.75) {
+		t.Errorf("bad Sub(): %v", got)
+	}
+
+	for _, pair := range [][2]Float{{a, null}, {null, a}, {null, null}} {
+		x, y := pair[0], pair[1]
+		if got := x.Add(y); got != (Float{}) {
+			t.Errorf("Add(%v, %v) should be null, got %v", x, y, got)
+		}
+		if got := x.Sub(y); got != (Float{}) {
+			t.Errorf("Sub(%v, %v) should be null, got %v", x, y, got)
+		}
+	}
+}
+
+func TestFloatMap(t *testing.T) {
+	assertFloat(t, FloatFrom(-1.2345).Map(math.Abs), "Map()")
+
+	called := false
+	null := NewFloat(-1.2345, false).Map(func(v float64) float64 {
+		called = true
+		return v
+	})
+	assertNullFloat(t, null, "null Map()")
+	if called {
+		t.Error("Map() should not call f for a null Float")
+	}
+}
+
+func TestFloatString(t *testing.T) {
+	f := FloatFrom(1.2345)
+	if f.String() != "1.2345" {
+		t.Errorf("bad String() output: %s\n", f.String())
+	}
+
+	null := NewFloat(1.2345, false)
+	if null.String() != "null" {
+		t.Errorf("bad null String() output: %s\n", null.String())
 	}
 }
 
@@ -135,6 +448,39 @@ func TestFloatSetValid(t *testing.T) {
 	assertFloat(t, change, "SetValid()")
 }
 
+func TestFloatSet(t *testing.T) {
+	var f Float
+	f.Set(1.2345, true)
+	assertFloat(t, f, "Set(x, true)")
+
+	f.Set(1.2345, false)
+	assertNullFloat(t, f, "Set(x, false)")
+	if f.Float64 != 1.2345 {
+		t.Errorf("Set(x, false) should keep the value, got %v", f.Float64)
+	}
+}
+
+func TestFloatSetNull(t *testing.T) {
+	f := FloatFrom(1.2345)
+	f.SetNull()
+	assertNullFloat(t, f, "SetNull()")
+	if f.Float64 != 0 {
+		t.Errorf("SetNull() should reset the value, got %v", f.Float64)
+	}
+}
+
+func TestFloatSetValidIf(t *testing.T) {
+	var f Float
+	f.SetValidIf(1.2345, true)
+	assertFloat(t, f, "SetValidIf(x, true)")
+
+	f.SetValidIf(1.2345, false)
+	assertNullFloat(t, f, "SetValidIf(x, false)")
+	if f.Float64 != 0 {
+		t.Errorf("SetValidIf(x, false) should reset the value, got %v", f.Float64)
+	}
+}
+
 func TestFloatScan(t *testing.T) {
 	var f Float
 	err := f.Scan(1.2345)
@@ -147,6 +493,84 @@ func TestFloatScan(t *testing.T) {
 	assertNullFloat(t, null, "scanned null")
 }
 
+func TestFloatScanNamed(t *testing.T) {
+	type namedFloat float64
+	for _, src := range []interface{}{namedFloat(1.2345), namedString("1.2345")} {
+		var f Float
+		err := f.Scan(src)
+		maybePanic(err)
+		assertFloat(t, f, fmt.Sprintf("scanned %T", src))
+	}
+
+	var i Float
+	err := i.Scan(namedInt(2))
+	maybePanic(err)
+	if !i.Valid || i.Float64 != 2 {
+		t.Errorf("bad scanned named int: %v", i)
+	}
+}
+
+func TestFloatScanText(t *testing.T) {
+	for _, tc := range []struct {
+		src  interface{}
+		want float64
+	}{
+		{"1.2345", 1.2345},
+		{[]byte(" 1.2345 "), 1.2345},
+		{"\t-42.5\n", -42.5},
+		{"1_000.5", 1000.5},
+		{"1e3", 1000},
+	} {
+		var f Float
+		err := f.Scan(tc.src)
+		maybePanic(err)
+		if !f.Valid || f.Float64 != tc.want {
+			t.Errorf("Scan(%q) = %v (valid: %t), want %v", tc.src, f.Float64, f.Valid, tc.want)
+		}
+	}
+
+	for _, src := range []string{"", "  ", "1.2.3", "abc", "_1", "1__0", "1e400"} {
+		bad := FloatFrom(1.2345)
+		if err := bad.Scan(src); err == nil {
+			t.Errorf("expected error scanning %q", src)
+		}
+		assertNullFloat(t, bad, "scanned malformed string")
+	}
+}
+
+func TestFloatPrecision(t *testing.T) {
+	defer func(p int) { FloatPrecision = p }(FloatPrecision)
+	tests := []struct {
+		f           float64
+		def, fixed2 string
+	}{
+		{1e-7, "0.0000001", "0.00"},
+		{0.125, "0.125", "0.12"},
+		{1e22, "10000000000000000000000", "10000000000000000000000.00"},
+		{-2.5, "-2.5", "-2.50"},
+	}
+	for _, tc := range tests {
+		for _, p := range []struct {
+			precision int
+			want      string
+		}{{-1, tc.def}, {2, tc.fixed2}} {
+			FloatPrecision = p.precision
+			data, err := json.Marshal(FloatFrom(tc.f))
+			maybePanic(err)
+			assertJSONEquals(t, data, p.want, "json marshal with precision "+strconv.Itoa(p.precision))
+
+			data, err = FloatFrom(tc.f).MarshalText()
+			maybePanic(err)
+			assertJSONEquals(t, data, p.want, "text marshal with precision "+strconv.Itoa(p.precision))
+		}
+	}
+
+	FloatPrecision = 2
+	data, err := json.Marshal(NewFloat(0, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal with precision 2")
+}
+
 func assertFloat(t *testing.T, f Float, from string) {
 	if f.Float64 != 1.2345 {
 		t.Errorf("bad %s float: %f ≠ %f\n", from, f.Float64, 1.2345)
@@ -161,3 +585,17 @@ func assertNullFloat(t *testing.T, f Float, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func assertFloatEqualIsTrue(t *testing.T, a, b Float) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Float{%v, Valid:%t} and Float{%v, Valid:%t} should return true", a.Float64, a.Valid, b.Float64, b.Valid)
+	}
+}
+
+func assertFloatEqualIsFalse(t *testing.T, a, b Float) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Float{%v, Valid:%t} and Float{%v, Valid:%t} should return false", a.Float64, a.Valid, b.Float64, b.Valid)
+	}
+}
@@ -0,0 +1,53 @@
+package null
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ParseString returns the first value for key in values.
+// It will be null if key is missing or its value is blank.
+func ParseString(values url.Values, key string) String {
+	s := values.Get(key)
+	return NewString(s, s != "")
+}
+
+// ParseInt parses the first value for key in values as a base 10 integer.
+// It will be null if key is missing or its value is blank,
+// and an error naming key is returned if the value is not an integer.
+func ParseInt(values url.Values, key string) (Int, error) {
+	i, err := IntFromString(values.Get(key))
+	return i, formError(key, err)
+}
+
+// ParseFloat parses the first value for key in values as a number.
+// It will be null if key is missing or its value is blank,
+// and an error naming key is returned if the value is not a number.
+func ParseFloat(values url.Values, key string) (Float, error) {
+	f, err := FloatFromString(values.Get(key))
+	return f, formError(key, err)
+}
+
+// ParseBool parses the first value for key in values with strconv.ParseBool.
+// It will be null if key is missing or its value is blank,
+// and an error naming key is returned if the value is not a boolean.
+func ParseBool(values url.Values, key string) (Bool, error) {
+	b, err := BoolFromString(values.Get(key))
+	return b, formError(key, err)
+}
+
+// ParseTime parses the first value for key in values with the given layout.
+// It will be null if key is missing or its value is blank,
+// and an error naming key is returned if the value doesn't match layout.
+func ParseTime(values url.Values, key, layout string) (Time, error) {
+	t, err := TimeFromString(values.Get(key), layout)
+	return t, formError(key, err)
+}
+
+// formError wraps err, if any, with the key whose value failed to parse.
+func formError(key string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("null: invalid value for %q: %w", key, err)
+}
@@ -0,0 +1,95 @@
+package null
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+var formValues = url.Values{
+	"string": {"test"},
+	"int":    {"12345"},
+	"float":  {"1.2345"},
+	"bool":   {"true"},
+	"time":   {timeString},
+	"blank":  {""},
+	"bad":    {"hello"},
+}
+
+func TestParseString(t *testing.T) {
+	assertStr(t, ParseString(formValues, "string"), "ParseString()")
+	assertNullStr(t, ParseString(formValues, "blank"), "ParseString() blank")
+	assertNullStr(t, ParseString(formValues, "missing"), "ParseString() missing")
+}
+
+func TestParseInt(t *testing.T) {
+	i, err := ParseInt(formValues, "int")
+	maybePanic(err)
+	assertInt(t, i, "ParseInt()")
+
+	for _, key := range []string{"blank", "missing"} {
+		null, err := ParseInt(formValues, key)
+		maybePanic(err)
+		assertNullInt(t, null, "ParseInt() "+key)
+	}
+
+	invalid, err := ParseInt(formValues, "bad")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt(t, invalid, "ParseInt() invalid")
+}
+
+func TestParseFloat(t *testing.T) {
+	f, err := ParseFloat(formValues, "float")
+	maybePanic(err)
+	assertFloat(t, f, "ParseFloat()")
+
+	for _, key := range []string{"blank", "missing"} {
+		null, err := ParseFloat(formValues, key)
+		maybePanic(err)
+		assertNullFloat(t, null, "ParseFloat() "+key)
+	}
+
+	invalid, err := ParseFloat(formValues, "bad")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullFloat(t, invalid, "ParseFloat() invalid")
+}
+
+func TestParseBool(t *testing.T) {
+	b, err := ParseBool(formValues, "bool")
+	maybePanic(err)
+	assertBool(t, b, "ParseBool()")
+
+	for _, key := range []string{"blank", "missing"} {
+		null, err := ParseBool(formValues, key)
+		maybePanic(err)
+		assertNullBool(t, null, "ParseBool() "+key)
+	}
+
+	invalid, err := ParseBool(formValues, "bad")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBool(t, invalid, "ParseBool() invalid")
+}
+
+func TestParseTime(t *testing.T) {
+	ti, err := ParseTime(formValues, "time", time.RFC3339)
+	maybePanic(err)
+	assertTime(t, ti, "ParseTime()")
+
+	for _, key := range []string{"blank", "missing"} {
+		null, err := ParseTime(formValues, key, time.RFC3339)
+		maybePanic(err)
+		assertNullTime(t, null, "ParseTime() "+key)
+	}
+
+	invalid, err := ParseTime(formValues, "bad", time.RFC3339)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, invalid, "ParseTime() invalid")
+}
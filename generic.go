@@ -0,0 +1,115 @@
+//go:build go1.18
+
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Null is a nullable value of any type T. It supports JSON serialization.
+// It will marshal to null if null, and otherwise delegates to encoding/json for T.
+// Because its value is held in the Value field, it does not implement driver.Valuer.
+type Null[T any] struct {
+	Value T
+	Valid bool
+}
+
+// NewValue creates a new Null.
+func NewValue[T any](v T, valid bool) Null[T] {
+	return Null[T]{
+		Value: v,
+		Valid: valid,
+	}
+}
+
+// ValueFrom creates a new Null that will always be valid.
+func ValueFrom[T any](v T) Null[T] {
+	return NewValue(v, true)
+}
+
+// ValueFromPtr creates a new Null that will be null if v is nil.
+func ValueFromPtr[T any](v *T) Null[T] {
+	if v == nil {
+		var zero T
+		return NewValue(zero, false)
+	}
+	return NewValue(*v, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports null input, and otherwise any input encoding/json can decode into T.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if kindOf(bytes.TrimSpace(data)) == jsonNull {
+		n.Valid = false
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Value); err != nil {
+		n.Valid = false
+		return unmarshalError(data, fmt.Sprintf("%T", *n), err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this value is null.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
+// SetValid changes this value and also sets it to be non-null.
+func (n *Null[T]) SetValid(v T) {
+	n.Value = v
+	n.Valid = true
+}
+
+// SetNull sets this value to null, resetting its value to zero.
+func (n *Null[T]) SetNull() {
+	var zero T
+	n.Value = zero
+	n.Valid = false
+}
+
+// Ptr returns a pointer to this value, or a nil pointer if it is null.
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Value
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (n Null[T]) ValueOrZero() T {
+	if !n.Valid {
+		var zero T
+		return zero
+	}
+	return n.Value
+}
+
+// IsNull returns true if this value is null.
+func (n Null[T]) IsNull() bool {
+	return !n.Valid
+}
+
+// Null is the same as IsNull.
+func (n Null[T]) Null() bool {
+	return !n.Valid
+}
+
+// IsValid returns true if this value is not null.
+func (n Null[T]) IsValid() bool {
+	return n.Valid
+}
+
+// IsZero returns true for null values and for valid ones holding T's zero value, for omitempty support.
+// Use IsValid to tell a null value from a valid zero one.
+func (n Null[T]) IsZero() bool {
+	return !n.Valid || reflect.ValueOf(&n.Value).Elem().IsZero()
+}
@@ -0,0 +1,209 @@
+//go:build go1.18
+
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var _ Nullable = (*Null[int])(nil)
+
+type genericPoint struct {
+	X, Y int
+}
+
+func TestValueFrom(t *testing.T) {
+	i := ValueFrom(12345)
+	if !i.Valid || i.Value != 12345 {
+		t.Errorf("bad ValueFrom() int: %v (valid: %t)\n", i.Value, i.Valid)
+	}
+
+	zero := ValueFrom("")
+	if !zero.Valid {
+		t.Error("ValueFrom(\"\")", "is invalid, but should be valid")
+	}
+}
+
+func TestValueFromPtr(t *testing.T) {
+	s := "test"
+	str := ValueFromPtr(&s)
+	if !str.Valid || str.Value != "test" {
+		t.Errorf("bad ValueFromPtr() string: %v (valid: %t)\n", str.Value, str.Valid)
+	}
+
+	null := ValueFromPtr[string](nil)
+	if null.Valid {
+		t.Error("ValueFromPtr(nil)", "is valid, but should be invalid")
+	}
+}
+
+func TestUnmarshalGeneric(t *testing.T) {
+	var i Null[int]
+	err := json.Unmarshal(intJSON, &i)
+	maybePanic(err)
+	if !i.Valid || i.Value != 12345 {
+		t.Errorf("bad int json: %v (valid: %t)\n", i.Value, i.Valid)
+	}
+
+	var str Null[string]
+	err = json.Unmarshal(stringJSON, &str)
+	maybePanic(err)
+	if !str.Valid || str.Value != "test" {
+		t.Errorf("bad string json: %v (valid: %t)\n", str.Value, str.Valid)
+	}
+
+	var p Null[genericPoint]
+	err = json.Unmarshal([]byte(`{"X":1,"Y":2}`), &p)
+	maybePanic(err)
+	if !p.Valid || p.Value != (genericPoint{1, 2}) {
+		t.Errorf("bad struct json: %v (valid: %t)\n", p.Value, p.Valid)
+	}
+
+	var null Null[genericPoint]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+
+	var badType Null[int]
+	err = json.Unmarshal(stringJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	if badType.Valid {
+		t.Error("wrong type json", "is valid, but should be invalid")
+	}
+}
+
+func TestMarshalGeneric(t *testing.T) {
+	data, err := json.Marshal(ValueFrom(12345))
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "int json marshal")
+
+	data, err = json.Marshal(ValueFrom("test"))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"test"`, "string json marshal")
+
+	data, err = json.Marshal(ValueFrom(genericPoint{1, 2}))
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"X":1,"Y":2}`, "struct json marshal")
+
+	data, err = json.Marshal(Null[genericPoint]{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalGenericNeverEmpty(t *testing.T) {
+	values := []json.Marshaler{
+		ValueFrom(0),
+		Null[string]{},
+		ValueFrom(JSON{Valid: true}),
+		ValueFrom[interface{}](nil),
+		Map[string, int]{},
+		Map[string, int]{Valid: true},
+		Slice[int]{},
+		Slice[int]{Valid: true},
+		Optional[int]{},
+		OptionalFrom(""),
+		statusEnum{Valid: true},
+	}
+	for _, v := range values {
+		out, err := v.MarshalJSON()
+		maybePanic(err)
+		if len(out) == 0 || !json.Valid(out) {
+			t.Errorf("%T.MarshalJSON() = %q, want valid JSON", v, out)
+		}
+	}
+}
+
+func TestGenericNullMethod(t *testing.T) {
+	if ValueFrom(0).Null() || !(Null[int]{}).Null() {
+		t.Error("Null() should be the complement of Valid")
+	}
+	if MapFrom(map[string]int{}).Null() || !(Map[string, int]{}).Null() {
+		t.Error("Map.Null() should be the complement of Valid")
+	}
+	if SliceFrom([]int{}).Null() || !(Slice[int]{}).Null() {
+		t.Error("Slice.Null() should be the complement of Valid")
+	}
+}
+
+func TestGenericPointer(t *testing.T) {
+	i := ValueFrom(12345)
+	ptr := i.Ptr()
+	if *ptr != 12345 {
+		t.Errorf("bad %s int: %#v ≠ %d\n", "pointer", ptr, 12345)
+	}
+
+	null := NewValue(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s int: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestGenericValueOrZero(t *testing.T) {
+	valid := ValueFrom("test")
+	if valid.ValueOrZero() != "test" {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewValue(genericPoint{1, 2}, false)
+	if invalid.ValueOrZero() != (genericPoint{}) {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestGenericIsZero(t *testing.T) {
+	if ValueFrom(1).IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+	if !ValueFrom(0).IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !ValueFrom(0).IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+	if !ValueFrom(genericPoint{}).IsZero() || ValueFrom(genericPoint{X: 1}).IsZero() {
+		t.Errorf("unexpected IsZero() for struct values")
+	}
+	if !(Null[int]{}).IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if (Null[int]{}).IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+}
+
+func TestGenericSetValid(t *testing.T) {
+	var change Null[string]
+	if change.Valid {
+		t.Error("SetValid()", "is valid, but should be invalid")
+	}
+	change.SetValid("test")
+	if !change.Valid || change.Value != "test" {
+		t.Errorf("bad SetValid() string: %v (valid: %t)\n", change.Value, change.Valid)
+	}
+}
+
+func TestUnmarshalBlankJSONGeneric(t *testing.T) {
+	for _, data := range [][]byte{{}, []byte(" \n\t")} {
+		n := ValueFrom(42)
+		m := MapFrom(map[string]int{"a": 1})
+		s := SliceFrom([]string{"a"})
+		e := MustEnum[testStatus]("active")
+		for _, u := range []interface {
+			json.Unmarshaler
+			Nullable
+		}{&n, &m, &s, &e} {
+			if err := u.UnmarshalJSON(data); err != nil {
+				t.Errorf("%T: unexpected error for %q: %v", u, data, err)
+			}
+			if !u.IsNull() {
+				t.Errorf("%T: %q should decode to null", u, data)
+			}
+		}
+	}
+}
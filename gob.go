@@ -0,0 +1,223 @@
+package null
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// Gob encodings start with a single byte holding the Valid flag,
+// followed by the value for valid values. Null values are just the flag.
+const (
+	gobNull  byte = 0
+	gobValid byte = 1
+)
+
+// gobDecodeValid splits data into its Valid flag and encoded value.
+func gobDecodeValid(data []byte, typ string) (bool, []byte, error) {
+	if len(data) == 0 {
+		return false, nil, errors.New("null: cannot decode empty gob data into " + typ)
+	}
+	switch data[0] {
+	case gobNull:
+		return false, nil, nil
+	case gobValid:
+		return true, data[1:], nil
+	}
+	return false, nil, errors.New("null: invalid gob data for " + typ)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s String) GobEncode() ([]byte, error) {
+	if !s.Valid {
+		return []byte{gobNull}, nil
+	}
+	return append([]byte{gobValid}, s.String...), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *String) GobDecode(data []byte) error {
+	valid, data, err := gobDecodeValid(data, "null.String")
+	if err != nil {
+		return err
+	}
+	s.String, s.Valid = string(data), valid
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Int) GobEncode() ([]byte, error) {
+	if !i.Valid {
+		return []byte{gobNull}, nil
+	}
+	data := make([]byte, 9)
+	data[0] = gobValid
+	binary.BigEndian.PutUint64(data[1:], uint64(i.Int64))
+	return data, nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Int) GobDecode(data []byte) error {
+	valid, data, err := gobDecodeValid(data, "null.Int")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		i.Int64, i.Valid = 0, false
+		return nil
+	}
+	if len(data) != 8 {
+		return errors.New("null: invalid gob data for null.Int")
+	}
+	i.Int64, i.Valid = int64(binary.BigEndian.Uint64(data)), true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (f Float) GobEncode() ([]byte, error) {
+	if !f.Valid {
+		return []byte{gobNull}, nil
+	}
+	data := make([]byte, 9)
+	data[0] = gobValid
+	binary.BigEndian.PutUint64(data[1:], math.Float64bits(f.Float64))
+	return data, nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (f *Float) GobDecode(data []byte) error {
+	valid, data, err := gobDecodeValid(data, "null.Float")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		f.Float64, f.Valid = 0, false
+		return nil
+	}
+	if len(data) != 8 {
+		return errors.New("null: invalid gob data for null.Float")
+	}
+	f.Float64, f.Valid = math.Float64frombits(binary.BigEndian.Uint64(data)), true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (b Bool) GobEncode() ([]byte, error) {
+	if !b.Valid {
+		return []byte{gobNull}, nil
+	}
+	if !b.Bool {
+		return []byte{gobValid, 0}, nil
+	}
+	return []byte{gobValid, 1}, nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (b *Bool) GobDecode(data []byte) error {
+	valid, data, err := gobDecodeValid(data, "null.Bool")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		b.Bool, b.Valid = false, false
+		return nil
+	}
+	if len(data) != 1 {
+		return errors.New("null: invalid gob data for null.Bool")
+	}
+	b.Bool, b.Valid = data[0] != 0, true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+// Valid values are encoded with time.Time's MarshalBinary, which keeps the instant and zone offset,
+// followed by the name of the time's location unless it is UTC or Local.
+func (t Time) GobEncode() ([]byte, error) {
+	if !t.Valid {
+		return []byte{gobNull}, nil
+	}
+	data, err := t.Time.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	data = append([]byte{gobValid}, data...)
+	if name := t.Time.Location().String(); name != "UTC" && name != "Local" {
+		data = append(data, name...)
+	}
+	return data, nil
+}
+
+// GobDecode implements gob.GobDecoder.
+// The time is restored in its original location if this system's time zone database has it
+// and it gives the same offset, and otherwise in a fixed zone with the original name and offset.
+// Data encoded without a location name, as older versions did, decodes as time.Time's UnmarshalBinary does.
+func (t *Time) GobDecode(data []byte) error {
+	valid, data, err := gobDecodeValid(data, "null.Time")
+	if err != nil {
+		return err
+	}
+	if !valid {
+		t.Time, t.Valid = time.Time{}, false
+		return nil
+	}
+	var name string
+	if n := timeBinaryLen(data); n > 0 && len(data) > n {
+		data, name = data[:n], string(data[n:])
+	}
+	if err := t.Time.UnmarshalBinary(data); err != nil {
+		t.Valid = false
+		return err
+	}
+	if name != "" {
+		t.Time = timeInLocation(t.Time, name)
+	}
+	t.Valid = true
+	return nil
+}
+
+// timeInLocation returns t in the location called name, which must give the same offset as t,
+// or in a fixed zone called name if there is no such location.
+func timeInLocation(t time.Time, name string) time.Time {
+	_, offset := t.Zone()
+	if loc := loadLocation(name); loc != nil {
+		if _, locOffset := t.In(loc).Zone(); locOffset == offset {
+			return t.In(loc)
+		}
+	}
+	return t.In(time.FixedZone(name, offset))
+}
+
+// gobLocations caches the locations loaded by loadLocation, by name.
+var gobLocations sync.Map
+
+// loadLocation is time.LoadLocation, but caches each location it finds, so that decoding
+// a stream of Times doesn't read and parse tzdata again for every value.
+// Unknown names aren't cached, so that arbitrary input can't grow the cache; it returns nil for them.
+func loadLocation(name string) *time.Location {
+	if loc, ok := gobLocations.Load(name); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil
+	}
+	gobLocations.Store(name, loc)
+	return loc
+}
+
+// timeBinaryLen returns the length of the time.Time MarshalBinary encoding at the start of data,
+// or 0 if its version is unknown.
+func timeBinaryLen(data []byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	switch data[0] {
+	case 1:
+		return 15
+	case 2:
+		return 16
+	}
+	return 0
+}
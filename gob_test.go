@@ -0,0 +1,156 @@
+package null
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+)
+
+type gobRecord struct {
+	String String
+	Int    Int
+	Float  Float
+	Bool   Bool
+	Time   Time
+}
+
+func gobRoundTrip(in, out interface{}) {
+	var buf bytes.Buffer
+	maybePanic(gob.NewEncoder(&buf).Encode(in))
+	maybePanic(gob.NewDecoder(&buf).Decode(out))
+}
+
+func TestGobValid(t *testing.T) {
+	in := gobRecord{
+		String: StringFrom("test"),
+		Int:    IntFrom(12345),
+		Float:  FloatFrom(1.2345),
+		Bool:   BoolFrom(true),
+		Time:   TimeFrom(timeValue),
+	}
+	var out gobRecord
+	gobRoundTrip(in, &out)
+	assertStr(t, out.String, "gob string")
+	assertInt(t, out.Int, "gob int")
+	assertFloat(t, out.Float, "gob float")
+	assertBool(t, out.Bool, "gob bool")
+	assertTime(t, out.Time, "gob time")
+}
+
+func TestGobZero(t *testing.T) {
+	in := gobRecord{
+		String: StringFrom(""),
+		Int:    IntFrom(0),
+		Float:  FloatFrom(0),
+		Bool:   BoolFrom(false),
+		Time:   TimeFrom(time.Time{}),
+	}
+	var out gobRecord
+	gobRoundTrip(in, &out)
+	if out != in {
+		t.Errorf("bad gob zero values: %v ≠ %v\n", out, in)
+	}
+}
+
+func TestGobNull(t *testing.T) {
+	// gob omits fields equal to their zero value, so encode each type on its own
+	var s String
+	gobRoundTrip(NewString("", false), &s)
+	assertNullStr(t, s, "gob null string")
+
+	var i = IntFrom(12345)
+	gobRoundTrip(NewInt(0, false), &i)
+	assertNullInt(t, i, "gob null int")
+
+	var f = FloatFrom(1.2345)
+	gobRoundTrip(NewFloat(0, false), &f)
+	assertNullFloat(t, f, "gob null float")
+
+	var b = BoolFrom(true)
+	gobRoundTrip(NewBool(false, false), &b)
+	assertNullBool(t, b, "gob null bool")
+
+	var ti = TimeFrom(timeValue)
+	gobRoundTrip(NewTime(time.Time{}, false), &ti)
+	assertNullTime(t, ti, "gob null time")
+}
+
+func TestGobTimeLocation(t *testing.T) {
+	zone := time.FixedZone("test", -5*60*60)
+	in := TimeFrom(timeValue.In(zone))
+	var out Time
+	gobRoundTrip(in, &out)
+	assertTime(t, out, "gob time with zone")
+	if _, offset := out.Time.Zone(); offset != -5*60*60 {
+		t.Errorf("bad gob time zone offset: %d ≠ %d\n", offset, -5*60*60)
+	}
+	if name := out.Time.Location().String(); name != "test" {
+		t.Errorf("bad gob time zone name: %s ≠ %s\n", name, "test")
+	}
+
+	utc := TimeFrom(timeValue)
+	out = Time{}
+	gobRoundTrip(utc, &out)
+	if out.Time.Location() != time.UTC || !out.Time.Equal(timeValue) {
+		t.Errorf("bad gob UTC time: %v", out.Time)
+	}
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no time zone database:", err)
+	}
+	for _, tv := range []time.Time{
+		time.Date(2012, time.December, 21, 12, 0, 0, 0, ny),
+		time.Date(2012, time.July, 4, 12, 0, 0, 0, ny),
+	} {
+		out = Time{}
+		gobRoundTrip(TimeFrom(tv), &out)
+		if !out.Valid || !out.Time.Equal(tv) || out.Time.Location().String() != "America/New_York" {
+			t.Errorf("bad gob named zone time: %v ≠ %v", out.Time, tv)
+		}
+		// the decoded location keeps its DST rules
+		if got, want := out.Time.AddDate(0, 6, 0), tv.AddDate(0, 6, 0); !got.Equal(want) || got.Format(time.RFC3339) != want.Format(time.RFC3339) {
+			t.Errorf("bad gob named zone rules: %v ≠ %v", got, want)
+		}
+	}
+
+	if loc := loadLocation("America/New_York"); loc == nil || loc != loadLocation("America/New_York") {
+		t.Error("loadLocation should cache the locations it loads")
+	}
+	if loc := loadLocation("test"); loc != nil {
+		t.Errorf("loadLocation of an unknown name = %v, want nil", loc)
+	}
+	if _, ok := gobLocations.Load("test"); ok {
+		t.Error("loadLocation should not cache unknown names")
+	}
+}
+
+func TestGobTimeLegacy(t *testing.T) {
+	// data encoded before location names were added is just the flag and MarshalBinary
+	tv := timeValue.In(time.FixedZone("test", -5*60*60))
+	bin, err := tv.MarshalBinary()
+	maybePanic(err)
+	var out Time
+	err = out.GobDecode(append([]byte{gobValid}, bin...))
+	maybePanic(err)
+	if !out.Valid || !out.Time.Equal(tv) {
+		t.Errorf("bad legacy gob time: %v ≠ %v", out.Time, tv)
+	}
+	if _, offset := out.Time.Zone(); offset != -5*60*60 {
+		t.Errorf("bad legacy gob time zone offset: %d", offset)
+	}
+}
+
+func TestGobDecodeInvalid(t *testing.T) {
+	var i Int
+	if err := i.GobDecode(nil); err == nil {
+		t.Error("expected error")
+	}
+	if err := i.GobDecode([]byte{2}); err == nil {
+		t.Error("expected error")
+	}
+	if err := i.GobDecode([]byte{1, 0}); err == nil {
+		t.Error("expected error")
+	}
+}
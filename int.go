@@ -1,11 +1,14 @@
 package null
 
 import (
+	"bytes"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"reflect"
+	"math"
 	"strconv"
+	"strings"
 )
 
 // Int is an nullable int64.
@@ -38,36 +41,106 @@ func IntFromPtr(i *int64) Int {
 	return NewInt(*i, true)
 }
 
+// IntFromSQL creates a new Int with the same value and validity as i.
+// The embedded NullInt64 field converts back the other way.
+func IntFromSQL(i sql.NullInt64) Int {
+	return Int{NullInt64: i}
+}
+
+// IntFromString creates a new Int by parsing s as a base 10 integer.
+// It will be null if s is blank, and an error is returned if s is not an integer.
+func IntFromString(s string) (Int, error) {
+	if s == "" {
+		return NewInt(0, false), nil
+	}
+	i, err := strconv.ParseInt(s, 10, 64)
+	return NewInt(i, err == nil), err
+}
+
+// MustInt is like IntFromString but panics if s is not an integer.
+// It is intended for test fixtures and package-level variables.
+func MustInt(s string) Int {
+	i, err := IntFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports number and null input.
+// It supports number and null input, and numbers quoted as strings, such as "42"; a blank string produces a null Int.
 // 0 will not be considered a null Int.
-// It also supports unmarshalling a sql.NullInt64.
-func (i *Int) UnmarshalJSON(data []byte) error {
-	var err error
-	var v interface{}
-	json.Unmarshal(data, &v)
-	switch v.(type) {
-	case float64:
-		// Unmarshal again, directly to int64, to avoid intermediate float64
-		err = json.Unmarshal(data, &i.Int64)
-	case map[string]interface{}:
+// It also supports unmarshalling a sql.NullInt64, which is null unless its Valid field is true.
+func (i *Int) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Int")
+	switch data = bytes.TrimSpace(data); kindOf(data) {
+	case jsonNumber:
+		// Parse the raw token, never going through float64, so every int64 survives exactly
+		i.Int64, err = strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			err = fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Int: %w", data, err)
+		}
+	case jsonString:
+		var str string
+		if str, err = unquoteNumber(data, "null.Int"); err != nil {
+			break
+		}
+		if str == "" {
+			i.Valid = false
+			return nil
+		}
+		i.Int64, err = strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			err = fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Int: %w", data, err)
+		}
+	case jsonObject:
+		i.NullInt64 = sql.NullInt64{}
 		err = json.Unmarshal(data, &i.NullInt64)
-	case nil:
+		i.Valid = err == nil && i.Valid
+		return err
+	case jsonNull:
 		i.Valid = false
 		return nil
 	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", reflect.TypeOf(v).Name())
+		err = jsonTypeError(data, "null.Int")
 	}
 	i.Valid = err == nil
 	return err
 }
 
+// UnmarshalJSONStrict is like UnmarshalJSON, but returns an error for input that
+// would produce a null Int, such as null.
+// Call it from a custom UnmarshalJSON to reject missing values for required fields.
+func (i *Int) UnmarshalJSONStrict(data []byte) error {
+	if err := i.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	if !i.Valid {
+		return strictNullError(data, "null.Int")
+	}
+	return nil
+}
+
+// UnmarshalJSONValidate is like UnmarshalJSON, but also calls validate with the decoded value.
+// If validate returns an error, this Int is set to null and the error is returned wrapped.
+// Input that produces a null Int is not validated.
+func (i *Int) UnmarshalJSONValidate(data []byte, validate func(int64) error) error {
+	if err := i.UnmarshalJSON(data); err != nil || !i.Valid {
+		return err
+	}
+	if err := validate(i.Int64); err != nil {
+		i.Valid = false
+		return validateError(data, "null.Int", err)
+	}
+	return nil
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Int if the input is a blank or not an integer.
+// It will unmarshal to a null Int if the input is blank or "null", or matches NullText.
 // It will return an error if the input is not an integer, blank, or "null".
 func (i *Int) UnmarshalText(text []byte) error {
 	str := string(text)
-	if str == "" || str == "null" {
+	if str == "null" || isNullText(text) {
 		i.Valid = false
 		return nil
 	}
@@ -78,29 +151,118 @@ func (i *Int) UnmarshalText(text []byte) error {
 }
 
 // MarshalJSON implements json.Marshaler.
-// It will encode null if this Int is null.
+// It will encode null if this Int is null, or its zero value if NullZero is set.
 func (i Int) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(make([]byte, 0, 20))
+}
+
+// AppendJSON appends the JSON encoding of this Int to dst, as MarshalJSON would encode it.
+// Encoders can reuse dst across calls to avoid allocating for every value.
+func (i Int) AppendJSON(dst []byte) ([]byte, error) {
 	if !i.Valid {
-		return []byte("null"), nil
+		if NullZero {
+			return append(dst, '0'), nil
+		}
+		return append(dst, "null"...), nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	return strconv.AppendInt(dst, i.Int64, 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It will encode a blank string if this Int is null.
+// It will encode NullText, blank by default, if this Int is null.
 func (i Int) MarshalText() ([]byte, error) {
 	if !i.Valid {
-		return []byte{}, nil
+		return nullText(), nil
 	}
 	return []byte(strconv.FormatInt(i.Int64, 10)), nil
 }
 
+// Scan implements sql.Scanner.
+// In addition to the values sql.NullInt64 accepts, it supports float64 values
+// holding a whole number, as returned by some drivers for integer columns.
+// Fractional and out of range floats are an error.
+// Values of named types, such as `type MyInt int`, are scanned as their underlying type.
+// String and []byte values are parsed as base 10 integers after trimming surrounding
+// whitespace, and may use underscores between digits as in "1_000".
+// A leading zero does not make them octal.
+func (i *Int) Scan(value interface{}) error {
+	var x float64
+	switch v := driverValue(value).(type) {
+	case float64:
+		x = v
+	case string:
+		return i.scanText(v)
+	case []byte:
+		return i.scanText(string(v))
+	default:
+		return i.NullInt64.Scan(v)
+	}
+	i.Int64, i.Valid = 0, false
+	switch {
+	case x != math.Trunc(x):
+		return fmt.Errorf("null: cannot scan %v into null.Int: not an integer", x)
+	case x < math.MinInt64 || x >= math.MaxInt64:
+		return fmt.Errorf("null: cannot scan %v into null.Int: out of range", x)
+	}
+	i.Int64, i.Valid = int64(x), true
+	return nil
+}
+
+// scanText parses s for Scan, trimming whitespace and allowing underscores between digits.
+func (i *Int) scanText(s string) error {
+	i.Int64, i.Valid = 0, false
+	s = strings.TrimSpace(s)
+	for j := 0; j < len(s); j++ {
+		if s[j] == '_' && (j == 0 || j == len(s)-1 || !isDigit(s[j-1]) || !isDigit(s[j+1])) {
+			return fmt.Errorf("null: cannot scan %q into null.Int: misplaced underscore", s)
+		}
+	}
+	n, err := strconv.ParseInt(strings.ReplaceAll(s, "_", ""), 10, 64)
+	switch {
+	case errors.Is(err, strconv.ErrRange):
+		return fmt.Errorf("null: cannot scan %q into null.Int: out of range", s)
+	case err != nil:
+		return fmt.Errorf("null: cannot scan %q into null.Int: not an integer", s)
+	}
+	i.Int64, i.Valid = n, true
+	return nil
+}
+
+// isDigit reports whether c is an ASCII decimal digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
 // SetValid changes this Int's value and also sets it to be non-null.
 func (i *Int) SetValid(n int64) {
 	i.Int64 = n
 	i.Valid = true
 }
 
+// Set changes this Int's value and validity at once.
+// Unlike SetValid, it can keep a value while marking this Int null.
+func (i *Int) Set(v int64, valid bool) {
+	i.Int64 = v
+	i.Valid = valid
+}
+
+// SetNull sets this Int to null, resetting its value to zero
+// so that stale data doesn't show through the Int64 field.
+func (i *Int) SetNull() {
+	i.Int64 = 0
+	i.Valid = false
+}
+
+// SetValidIf sets this Int to v if cond is true, and to null otherwise.
+// Unlike Set, it resets the value to zero when cond is false.
+func (i *Int) SetValidIf(v int64, cond bool) {
+	if !cond {
+		i.SetNull()
+		return
+	}
+	i.SetValid(v)
+}
+
 // Ptr returns a pointer to this Int's value, or a nil pointer if this Int is null.
 func (i Int) Ptr() *int64 {
 	if !i.Valid {
@@ -109,8 +271,176 @@ func (i Int) Ptr() *int64 {
 	return &i.Int64
 }
 
-// IsZero returns true for invalid Ints, for future omitempty support (Go 1.4?)
-// A non-null Int with a 0 value will not be considered zero.
-func (i Int) IsZero() bool {
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int) ValueOrZero() int64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int64
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (i Int) ValueOr(def int64) int64 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int64
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (i Int) Equal(other Int) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int64 == other.Int64)
+}
+
+// Compare returns -1 if this Int sorts before other, 1 if it sorts after, and 0 if they are equal,
+// for use with sort and slices.SortFunc. Valid values are ordered numerically.
+// Null values are equal to each other and sort before every valid value, or after if NullsLast is set.
+func (i Int) Compare(other Int) int {
+	if c, ok := compareNull(i.Valid, other.Valid); ok {
+		return c
+	}
+	switch {
+	case i.Int64 < other.Int64:
+		return -1
+	case i.Int64 > other.Int64:
+		return 1
+	}
+	return 0
+}
+
+// Changed returns true if this Int differs from old: if one is null and the other isn't,
+// or if both are valid with different values. It is the inverse of Equal.
+func (i Int) Changed(old Int) bool {
+	return !i.Equal(old)
+}
+
+// Or returns this Int if it is valid, otherwise other.
+func (i Int) Or(other Int) Int {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// Merge copies patch into this Int if patch is valid, and otherwise leaves it unchanged,
+// for applying partial updates where a null field means "keep the current value".
+func (i *Int) Merge(patch Int) {
+	if patch.Valid {
+		*i = patch
+	}
+}
+
+// Add returns i+j, or a null Int if either operand is null, as with NULL in SQL.
+// The result is also null if the sum overflows an int64.
+func (i Int) Add(j Int) Int {
+	if !i.Valid || !j.Valid {
+		return Int{}
+	}
+	sum := i.Int64 + j.Int64
+	if (sum > i.Int64) != (j.Int64 > 0) {
+		return Int{}
+	}
+	return IntFrom(sum)
+}
+
+// Sub returns i-j, or a null Int if either operand is null, as with NULL in SQL.
+// The result is also null if the difference overflows an int64.
+func (i Int) Sub(j Int) Int {
+	if !i.Valid || !j.Valid {
+		return Int{}
+	}
+	diff := i.Int64 - j.Int64
+	if (diff < i.Int64) != (j.Int64 > 0) {
+		return Int{}
+	}
+	return IntFrom(diff)
+}
+
+// Mul returns i*j, or a null Int if either operand is null, as with NULL in SQL.
+// The result is also null if the product overflows an int64.
+func (i Int) Mul(j Int) Int {
+	if !i.Valid || !j.Valid {
+		return Int{}
+	}
+	if i.Int64 == 0 || j.Int64 == 0 {
+		return IntFrom(0)
+	}
+	prod := i.Int64 * j.Int64
+	if prod/j.Int64 != i.Int64 || (i.Int64 == math.MinInt64 && j.Int64 == -1) {
+		return Int{}
+	}
+	return IntFrom(prod)
+}
+
+// Map returns a valid Int holding f applied to this Int's value,
+// or this Int unchanged if it is null. f is not called for a null Int.
+func (i Int) Map(f func(int64) int64) Int {
+	if !i.Valid {
+		return i
+	}
+	return IntFrom(f(i.Int64))
+}
+
+// ToBool converts this Int to a Bool, for columns that store booleans as integers.
+// 0 converts to false and 1 to true. Null and any other value convert to a null Bool.
+func (i Int) ToBool() Bool {
+	if !i.Valid || (i.Int64 != 0 && i.Int64 != 1) {
+		return NewBool(false, false)
+	}
+	return BoolFrom(i.Int64 == 1)
+}
+
+// ToString converts this Int to a String holding its value in base 10.
+// A null Int converts to a null String.
+func (i Int) ToString() String {
+	if !i.Valid {
+		return NewString("", false)
+	}
+	return StringFrom(strconv.FormatInt(i.Int64, 10))
+}
+
+// String implements fmt.Stringer.
+// It returns this Int's value in base 10, or NullDisplay if null.
+func (i Int) String() string {
+	if !i.Valid {
+		return NullDisplay
+	}
+	return strconv.FormatInt(i.Int64, 10)
+}
+
+// IsNull returns true if this Int is null.
+func (i Int) IsNull() bool {
 	return !i.Valid
 }
+
+// Null is the same as IsNull.
+func (i Int) Null() bool {
+	return !i.Valid
+}
+
+// IsValid returns true if this Int is not null.
+func (i Int) IsValid() bool {
+	return i.Valid
+}
+
+// IsZero returns true for null Ints and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Int from a valid zero one.
+func (i Int) IsZero() bool {
+	return !i.Valid || i.Int64 == 0
+}
+
+// parseSizedInt parses s as a base 10 integer that must fit in the given number of bits.
+// It is used by the sized integer types, and its range errors mention the type's bounds.
+func parseSizedInt(s string, bits int, typ string) (int64, error) {
+	n, err := strconv.ParseInt(s, 10, bits)
+	if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+		return 0, sizedIntRangeError(s, bits, typ)
+	}
+	return n, err
+}
+
+// sizedIntRangeError returns the error for s not fitting in a sized integer type.
+func sizedIntRangeError(s string, bits int, typ string) error {
+	min, max := int64(-1)<<uint(bits-1), int64(1)<<uint(bits-1)-1
+	return fmt.Errorf("null: %s overflows %s, which must be between %d and %d", s, typ, min, max)
+}
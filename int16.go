@@ -0,0 +1,147 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Int16 is a nullable int16.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Input that does not fit in an int16 is rejected rather than truncated.
+type Int16 struct {
+	sql.NullInt16
+}
+
+// NewInt16 creates a new Int16
+func NewInt16(i int16, valid bool) Int16 {
+	return Int16{
+		NullInt16: sql.NullInt16{
+			Int16: i,
+			Valid: valid,
+		},
+	}
+}
+
+// Int16From creates a new Int16 that will always be valid.
+func Int16From(i int16) Int16 {
+	return NewInt16(i, true)
+}
+
+// Int16FromPtr creates a new Int16 that be null if i is nil.
+func Int16FromPtr(i *int16) Int16 {
+	if i == nil {
+		return NewInt16(0, false)
+	}
+	return NewInt16(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will not be considered a null Int16.
+// It also supports unmarshalling a sql.NullInt16.
+// It will return an error if the number does not fit in an int16.
+func (i *Int16) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Int16")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch v.(type) {
+	case float64:
+		var n int64
+		n, err = parseSizedInt(string(data), 16, "null.Int16")
+		i.Int16 = int16(n)
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &i.NullInt16)
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int16", reflect.TypeOf(v).Name())
+	}
+	i.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int16 if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not an integer that fits in an int16.
+func (i *Int16) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseSizedInt(str, 16, "null.Int16")
+	i.Int16, i.Valid = int16(n), err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int16 is null.
+func (i Int16) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int16), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Int16 is null.
+func (i Int16) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int16), 10)), nil
+}
+
+// SetValid changes this Int16's value and also sets it to be non-null.
+func (i *Int16) SetValid(n int16) {
+	i.Int16 = n
+	i.Valid = true
+}
+
+// SetNull sets this Int16 to null, resetting its value to zero.
+func (i *Int16) SetNull() {
+	i.Int16 = 0
+	i.Valid = false
+}
+
+// Ptr returns a pointer to this Int16's value, or a nil pointer if this Int16 is null.
+func (i Int16) Ptr() *int16 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int16
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int16) ValueOrZero() int16 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int16
+}
+
+// IsNull returns true if this Int16 is null.
+func (i Int16) IsNull() bool {
+	return !i.Valid
+}
+
+// Null is the same as IsNull.
+func (i Int16) Null() bool {
+	return !i.Valid
+}
+
+// IsValid returns true if this Int16 is not null.
+func (i Int16) IsValid() bool {
+	return i.Valid
+}
+
+// IsZero returns true for null Int16s and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Int16 from a valid zero one.
+func (i Int16) IsZero() bool {
+	return !i.Valid || i.Int16 == 0
+}
@@ -0,0 +1,228 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var (
+	int16JSON     = []byte(`123`)
+	nullInt16JSON = []byte(`{"Int16":123,"Valid":true}`)
+)
+
+func TestInt16From(t *testing.T) {
+	i := Int16From(123)
+	assertInt16(t, i, "Int16From()")
+
+	zero := Int16From(0)
+	if !zero.Valid {
+		t.Error("Int16From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestInt16FromPtr(t *testing.T) {
+	n := int16(123)
+	i := Int16FromPtr(&n)
+	assertInt16(t, i, "Int16FromPtr()")
+
+	null := Int16FromPtr(nil)
+	assertNullInt16(t, null, "Int16FromPtr(nil)")
+}
+
+func TestUnmarshalInt16(t *testing.T) {
+	var i Int16
+	err := json.Unmarshal(int16JSON, &i)
+	maybePanic(err)
+	assertInt16(t, i, "int16 json")
+
+	var ni Int16
+	err = json.Unmarshal(nullInt16JSON, &ni)
+	maybePanic(err)
+	assertInt16(t, ni, "sql.NullInt16 json")
+
+	var null Int16
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt16(t, null, "null json")
+
+	var badType Int16
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt16(t, badType, "wrong type json")
+
+	var nonInteger Int16
+	err = json.Unmarshal(floatJSON, &nonInteger)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt16(t, nonInteger, "non-integer json")
+}
+
+func TestUnmarshalInt16Bounds(t *testing.T) {
+	var i Int16
+	err := json.Unmarshal([]byte(strconv.FormatInt(math.MaxInt16, 10)), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int16 != math.MaxInt16 {
+		t.Errorf("bad max int16 json: %d (valid: %t)\n", i.Int16, i.Valid)
+	}
+
+	err = json.Unmarshal([]byte(strconv.FormatInt(math.MinInt16, 10)), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int16 != math.MinInt16 {
+		t.Errorf("bad min int16 json: %d (valid: %t)\n", i.Int16, i.Valid)
+	}
+
+	for _, s := range []string{strconv.FormatInt(math.MaxInt16+1, 10), strconv.FormatInt(math.MinInt16-1, 10)} {
+		err = json.Unmarshal([]byte(s), &i)
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		} else if !strings.Contains(err.Error(), strconv.FormatInt(math.MaxInt16, 10)) {
+			t.Errorf("error for %s should mention the bound: %v", s, err)
+		}
+		assertNullInt16(t, i, "overflowing json")
+
+		err = i.UnmarshalText([]byte(s))
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullInt16(t, i, "overflowing text")
+	}
+}
+
+func TestTextUnmarshalInt16(t *testing.T) {
+	var i Int16
+	err := i.UnmarshalText([]byte("123"))
+	maybePanic(err)
+	assertInt16(t, i, "UnmarshalText() int16")
+
+	var blank Int16
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullInt16(t, blank, "UnmarshalText() empty int16")
+
+	var null Int16
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullInt16(t, null, `UnmarshalText() "null"`)
+}
+
+func TestMarshalInt16(t *testing.T) {
+	i := Int16From(123)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty json marshal")
+
+	data, err = i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty text marshal")
+
+	null := NewInt16(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestInt16Pointer(t *testing.T) {
+	i := Int16From(123)
+	ptr := i.Ptr()
+	if *ptr != 123 {
+		t.Errorf("bad %s int16: %#v ≠ %d\n", "pointer", ptr, 123)
+	}
+
+	null := NewInt16(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s int16: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestInt16IsZero(t *testing.T) {
+	i := Int16From(123)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewInt16(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := NewInt16(0, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestInt16ValueOrZero(t *testing.T) {
+	valid := Int16From(123)
+	if valid.ValueOrZero() != 123 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewInt16(123, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestInt16SetValid(t *testing.T) {
+	change := NewInt16(0, false)
+	assertNullInt16(t, change, "SetValid()")
+	change.SetValid(123)
+	assertInt16(t, change, "SetValid()")
+}
+
+func TestInt16Scan(t *testing.T) {
+	var i Int16
+	err := i.Scan(int64(123))
+	maybePanic(err)
+	assertInt16(t, i, "scanned int16")
+
+	v, err := i.Value()
+	maybePanic(err)
+	if v != int64(123) {
+		t.Errorf("bad int16 value: %v ≠ %v\n", v, 123)
+	}
+
+	var null Int16
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullInt16(t, null, "scanned null")
+
+	var big Int16
+	err = big.Scan(int64(math.MaxInt16) + 1)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt16(t, big, "scanned overflow")
+}
+
+func assertInt16(t *testing.T, i Int16, from string) {
+	if i.Int16 != 123 {
+		t.Errorf("bad %s int16: %d ≠ %d\n", from, i.Int16, 123)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullInt16(t *testing.T, i Int16, from string) {
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,147 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Int32 is a nullable int32.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Input that does not fit in an int32 is rejected rather than truncated.
+type Int32 struct {
+	sql.NullInt32
+}
+
+// NewInt32 creates a new Int32
+func NewInt32(i int32, valid bool) Int32 {
+	return Int32{
+		NullInt32: sql.NullInt32{
+			Int32: i,
+			Valid: valid,
+		},
+	}
+}
+
+// Int32From creates a new Int32 that will always be valid.
+func Int32From(i int32) Int32 {
+	return NewInt32(i, true)
+}
+
+// Int32FromPtr creates a new Int32 that be null if i is nil.
+func Int32FromPtr(i *int32) Int32 {
+	if i == nil {
+		return NewInt32(0, false)
+	}
+	return NewInt32(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will not be considered a null Int32.
+// It also supports unmarshalling a sql.NullInt32.
+// It will return an error if the number does not fit in an int32.
+func (i *Int32) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Int32")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch v.(type) {
+	case float64:
+		var n int64
+		n, err = parseSizedInt(string(data), 32, "null.Int32")
+		i.Int32 = int32(n)
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &i.NullInt32)
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int32", reflect.TypeOf(v).Name())
+	}
+	i.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int32 if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not an integer that fits in an int32.
+func (i *Int32) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseSizedInt(str, 32, "null.Int32")
+	i.Int32, i.Valid = int32(n), err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int32 is null.
+func (i Int32) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Int32 is null.
+func (i Int32) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+}
+
+// SetValid changes this Int32's value and also sets it to be non-null.
+func (i *Int32) SetValid(n int32) {
+	i.Int32 = n
+	i.Valid = true
+}
+
+// SetNull sets this Int32 to null, resetting its value to zero.
+func (i *Int32) SetNull() {
+	i.Int32 = 0
+	i.Valid = false
+}
+
+// Ptr returns a pointer to this Int32's value, or a nil pointer if this Int32 is null.
+func (i Int32) Ptr() *int32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int32
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int32) ValueOrZero() int32 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int32
+}
+
+// IsNull returns true if this Int32 is null.
+func (i Int32) IsNull() bool {
+	return !i.Valid
+}
+
+// Null is the same as IsNull.
+func (i Int32) Null() bool {
+	return !i.Valid
+}
+
+// IsValid returns true if this Int32 is not null.
+func (i Int32) IsValid() bool {
+	return i.Valid
+}
+
+// IsZero returns true for null Int32s and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Int32 from a valid zero one.
+func (i Int32) IsZero() bool {
+	return !i.Valid || i.Int32 == 0
+}
@@ -0,0 +1,228 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var (
+	int32JSON     = []byte(`123`)
+	nullInt32JSON = []byte(`{"Int32":123,"Valid":true}`)
+)
+
+func TestInt32From(t *testing.T) {
+	i := Int32From(123)
+	assertInt32(t, i, "Int32From()")
+
+	zero := Int32From(0)
+	if !zero.Valid {
+		t.Error("Int32From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestInt32FromPtr(t *testing.T) {
+	n := int32(123)
+	i := Int32FromPtr(&n)
+	assertInt32(t, i, "Int32FromPtr()")
+
+	null := Int32FromPtr(nil)
+	assertNullInt32(t, null, "Int32FromPtr(nil)")
+}
+
+func TestUnmarshalInt32(t *testing.T) {
+	var i Int32
+	err := json.Unmarshal(int32JSON, &i)
+	maybePanic(err)
+	assertInt32(t, i, "int32 json")
+
+	var ni Int32
+	err = json.Unmarshal(nullInt32JSON, &ni)
+	maybePanic(err)
+	assertInt32(t, ni, "sql.NullInt32 json")
+
+	var null Int32
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt32(t, null, "null json")
+
+	var badType Int32
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt32(t, badType, "wrong type json")
+
+	var nonInteger Int32
+	err = json.Unmarshal(floatJSON, &nonInteger)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt32(t, nonInteger, "non-integer json")
+}
+
+func TestUnmarshalInt32Bounds(t *testing.T) {
+	var i Int32
+	err := json.Unmarshal([]byte(strconv.FormatInt(math.MaxInt32, 10)), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int32 != math.MaxInt32 {
+		t.Errorf("bad max int32 json: %d (valid: %t)\n", i.Int32, i.Valid)
+	}
+
+	err = json.Unmarshal([]byte(strconv.FormatInt(math.MinInt32, 10)), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int32 != math.MinInt32 {
+		t.Errorf("bad min int32 json: %d (valid: %t)\n", i.Int32, i.Valid)
+	}
+
+	for _, s := range []string{strconv.FormatInt(math.MaxInt32+1, 10), strconv.FormatInt(math.MinInt32-1, 10)} {
+		err = json.Unmarshal([]byte(s), &i)
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		} else if !strings.Contains(err.Error(), strconv.FormatInt(math.MaxInt32, 10)) {
+			t.Errorf("error for %s should mention the bound: %v", s, err)
+		}
+		assertNullInt32(t, i, "overflowing json")
+
+		err = i.UnmarshalText([]byte(s))
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullInt32(t, i, "overflowing text")
+	}
+}
+
+func TestTextUnmarshalInt32(t *testing.T) {
+	var i Int32
+	err := i.UnmarshalText([]byte("123"))
+	maybePanic(err)
+	assertInt32(t, i, "UnmarshalText() int32")
+
+	var blank Int32
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullInt32(t, blank, "UnmarshalText() empty int32")
+
+	var null Int32
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullInt32(t, null, `UnmarshalText() "null"`)
+}
+
+func TestMarshalInt32(t *testing.T) {
+	i := Int32From(123)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty json marshal")
+
+	data, err = i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty text marshal")
+
+	null := NewInt32(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestInt32Pointer(t *testing.T) {
+	i := Int32From(123)
+	ptr := i.Ptr()
+	if *ptr != 123 {
+		t.Errorf("bad %s int32: %#v ≠ %d\n", "pointer", ptr, 123)
+	}
+
+	null := NewInt32(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s int32: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestInt32IsZero(t *testing.T) {
+	i := Int32From(123)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewInt32(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := NewInt32(0, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestInt32ValueOrZero(t *testing.T) {
+	valid := Int32From(123)
+	if valid.ValueOrZero() != 123 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewInt32(123, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestInt32SetValid(t *testing.T) {
+	change := NewInt32(0, false)
+	assertNullInt32(t, change, "SetValid()")
+	change.SetValid(123)
+	assertInt32(t, change, "SetValid()")
+}
+
+func TestInt32Scan(t *testing.T) {
+	var i Int32
+	err := i.Scan(int64(123))
+	maybePanic(err)
+	assertInt32(t, i, "scanned int32")
+
+	v, err := i.Value()
+	maybePanic(err)
+	if v != int64(123) {
+		t.Errorf("bad int32 value: %v ≠ %v\n", v, 123)
+	}
+
+	var null Int32
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullInt32(t, null, "scanned null")
+
+	var big Int32
+	err = big.Scan(int64(math.MaxInt32) + 1)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt32(t, big, "scanned overflow")
+}
+
+func assertInt32(t *testing.T, i Int32, from string) {
+	if i.Int32 != 123 {
+		t.Errorf("bad %s int32: %d ≠ %d\n", from, i.Int32, 123)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullInt32(t *testing.T, i Int32, from string) {
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,147 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Int64 is a nullable int64.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Input that does not fit in an int64 is rejected rather than truncated.
+type Int64 struct {
+	sql.NullInt64
+}
+
+// NewInt64 creates a new Int64
+func NewInt64(i int64, valid bool) Int64 {
+	return Int64{
+		NullInt64: sql.NullInt64{
+			Int64: i,
+			Valid: valid,
+		},
+	}
+}
+
+// Int64From creates a new Int64 that will always be valid.
+func Int64From(i int64) Int64 {
+	return NewInt64(i, true)
+}
+
+// Int64FromPtr creates a new Int64 that be null if i is nil.
+func Int64FromPtr(i *int64) Int64 {
+	if i == nil {
+		return NewInt64(0, false)
+	}
+	return NewInt64(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will not be considered a null Int64.
+// It also supports unmarshalling a sql.NullInt64.
+// It will return an error if the number does not fit in an int64.
+func (i *Int64) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Int64")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch v.(type) {
+	case float64:
+		var n int64
+		n, err = parseSizedInt(string(data), 64, "null.Int64")
+		i.Int64 = int64(n)
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &i.NullInt64)
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int64", reflect.TypeOf(v).Name())
+	}
+	i.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int64 if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not an integer that fits in an int64.
+func (i *Int64) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseSizedInt(str, 64, "null.Int64")
+	i.Int64, i.Valid = int64(n), err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int64 is null.
+func (i Int64) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int64), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Int64 is null.
+func (i Int64) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int64), 10)), nil
+}
+
+// SetValid changes this Int64's value and also sets it to be non-null.
+func (i *Int64) SetValid(n int64) {
+	i.Int64 = n
+	i.Valid = true
+}
+
+// SetNull sets this Int64 to null, resetting its value to zero.
+func (i *Int64) SetNull() {
+	i.Int64 = 0
+	i.Valid = false
+}
+
+// Ptr returns a pointer to this Int64's value, or a nil pointer if this Int64 is null.
+func (i Int64) Ptr() *int64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int64
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int64) ValueOrZero() int64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int64
+}
+
+// IsNull returns true if this Int64 is null.
+func (i Int64) IsNull() bool {
+	return !i.Valid
+}
+
+// Null is the same as IsNull.
+func (i Int64) Null() bool {
+	return !i.Valid
+}
+
+// IsValid returns true if this Int64 is not null.
+func (i Int64) IsValid() bool {
+	return i.Valid
+}
+
+// IsZero returns true for null Int64s and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Int64 from a valid zero one.
+func (i Int64) IsZero() bool {
+	return !i.Valid || i.Int64 == 0
+}
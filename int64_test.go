@@ -0,0 +1,221 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var (
+	int64JSON     = []byte(`123`)
+	nullInt64JSON = []byte(`{"Int64":123,"Valid":true}`)
+)
+
+func TestInt64From(t *testing.T) {
+	i := Int64From(123)
+	assertInt64(t, i, "Int64From()")
+
+	zero := Int64From(0)
+	if !zero.Valid {
+		t.Error("Int64From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestInt64FromPtr(t *testing.T) {
+	n := int64(123)
+	i := Int64FromPtr(&n)
+	assertInt64(t, i, "Int64FromPtr()")
+
+	null := Int64FromPtr(nil)
+	assertNullInt64(t, null, "Int64FromPtr(nil)")
+}
+
+func TestUnmarshalInt64(t *testing.T) {
+	var i Int64
+	err := json.Unmarshal(int64JSON, &i)
+	maybePanic(err)
+	assertInt64(t, i, "int64 json")
+
+	var ni Int64
+	err = json.Unmarshal(nullInt64JSON, &ni)
+	maybePanic(err)
+	assertInt64(t, ni, "sql.NullInt64 json")
+
+	var null Int64
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt64(t, null, "null json")
+
+	var badType Int64
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt64(t, badType, "wrong type json")
+
+	var nonInteger Int64
+	err = json.Unmarshal(floatJSON, &nonInteger)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt64(t, nonInteger, "non-integer json")
+}
+
+func TestUnmarshalInt64Bounds(t *testing.T) {
+	var i Int64
+	err := json.Unmarshal([]byte(strconv.FormatInt(math.MaxInt64, 10)), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int64 != math.MaxInt64 {
+		t.Errorf("bad max int64 json: %d (valid: %t)\n", i.Int64, i.Valid)
+	}
+
+	err = json.Unmarshal([]byte(strconv.FormatInt(math.MinInt64, 10)), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int64 != math.MinInt64 {
+		t.Errorf("bad min int64 json: %d (valid: %t)\n", i.Int64, i.Valid)
+	}
+
+	for _, s := range []string{"9223372036854775808", "-9223372036854775809"} {
+		err = json.Unmarshal([]byte(s), &i)
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		} else if !strings.Contains(err.Error(), strconv.FormatInt(math.MaxInt64, 10)) {
+			t.Errorf("error for %s should mention the bound: %v", s, err)
+		}
+		assertNullInt64(t, i, "overflowing json")
+
+		err = i.UnmarshalText([]byte(s))
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullInt64(t, i, "overflowing text")
+	}
+}
+
+func TestTextUnmarshalInt64(t *testing.T) {
+	var i Int64
+	err := i.UnmarshalText([]byte("123"))
+	maybePanic(err)
+	assertInt64(t, i, "UnmarshalText() int64")
+
+	var blank Int64
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullInt64(t, blank, "UnmarshalText() empty int64")
+
+	var null Int64
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullInt64(t, null, `UnmarshalText() "null"`)
+}
+
+func TestMarshalInt64(t *testing.T) {
+	i := Int64From(123)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty json marshal")
+
+	data, err = i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty text marshal")
+
+	null := NewInt64(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestInt64Pointer(t *testing.T) {
+	i := Int64From(123)
+	ptr := i.Ptr()
+	if *ptr != 123 {
+		t.Errorf("bad %s int64: %#v ≠ %d\n", "pointer", ptr, 123)
+	}
+
+	null := NewInt64(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s int64: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestInt64IsZero(t *testing.T) {
+	i := Int64From(123)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewInt64(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := NewInt64(0, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestInt64ValueOrZero(t *testing.T) {
+	valid := Int64From(123)
+	if valid.ValueOrZero() != 123 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewInt64(123, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestInt64SetValid(t *testing.T) {
+	change := NewInt64(0, false)
+	assertNullInt64(t, change, "SetValid()")
+	change.SetValid(123)
+	assertInt64(t, change, "SetValid()")
+}
+
+func TestInt64Scan(t *testing.T) {
+	var i Int64
+	err := i.Scan(int64(123))
+	maybePanic(err)
+	assertInt64(t, i, "scanned int64")
+
+	v, err := i.Value()
+	maybePanic(err)
+	if v != int64(123) {
+		t.Errorf("bad int64 value: %v ≠ %v\n", v, 123)
+	}
+
+	var null Int64
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullInt64(t, null, "scanned null")
+}
+
+func assertInt64(t *testing.T, i Int64, from string) {
+	if i.Int64 != 123 {
+		t.Errorf("bad %s int64: %d ≠ %d\n", from, i.Int64, 123)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullInt64(t *testing.T, i Int64, from string) {
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,174 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Int8 is a nullable int8.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Input that does not fit in an int8 is rejected rather than truncated.
+type Int8 struct {
+	Int8  int8
+	Valid bool
+}
+
+// NewInt8 creates a new Int8
+func NewInt8(i int8, valid bool) Int8 {
+	return Int8{
+		Int8:  i,
+		Valid: valid,
+	}
+}
+
+// Int8From creates a new Int8 that will always be valid.
+func Int8From(i int8) Int8 {
+	return NewInt8(i, true)
+}
+
+// Int8FromPtr creates a new Int8 that be null if i is nil.
+func Int8FromPtr(i *int8) Int8 {
+	if i == nil {
+		return NewInt8(0, false)
+	}
+	return NewInt8(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will not be considered a null Int8.
+// It will return an error if the number does not fit in an int8.
+func (i *Int8) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Int8")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch v.(type) {
+	case float64:
+		var n int64
+		n, err = parseSizedInt(string(data), 8, "null.Int8")
+		i.Int8 = int8(n)
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int8", reflect.TypeOf(v).Name())
+	}
+	i.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int8 if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not an integer that fits in an int8.
+func (i *Int8) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseSizedInt(str, 8, "null.Int8")
+	i.Int8, i.Valid = int8(n), err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int8 is null.
+func (i Int8) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int8), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Int8 is null.
+func (i Int8) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int8), 10)), nil
+}
+
+// Scan implements sql.Scanner.
+// It will return an error if the value does not fit in an int8.
+func (i *Int8) Scan(value interface{}) error {
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		i.Valid = false
+		return err
+	}
+	if !n.Valid {
+		i.Valid = false
+		return nil
+	}
+	if n.Int64 < math.MinInt8 || n.Int64 > math.MaxInt8 {
+		i.Valid = false
+		return sizedIntRangeError(strconv.FormatInt(n.Int64, 10), 8, "null.Int8")
+	}
+	i.Int8, i.Valid = int8(n.Int64), true
+	return nil
+}
+
+// Value implements driver.Valuer.
+// It returns an int64, or nil if this Int8 is null.
+func (i Int8) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Int8), nil
+}
+
+// SetValid changes this Int8's value and also sets it to be non-null.
+func (i *Int8) SetValid(n int8) {
+	i.Int8 = n
+	i.Valid = true
+}
+
+// SetNull sets this Int8 to null, resetting its value to zero.
+func (i *Int8) SetNull() {
+	i.Int8 = 0
+	i.Valid = false
+}
+
+// Ptr returns a pointer to this Int8's value, or a nil pointer if this Int8 is null.
+func (i Int8) Ptr() *int8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int8
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int8) ValueOrZero() int8 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int8
+}
+
+// IsNull returns true if this Int8 is null.
+func (i Int8) IsNull() bool {
+	return !i.Valid
+}
+
+// Null is the same as IsNull.
+func (i Int8) Null() bool {
+	return !i.Valid
+}
+
+// IsValid returns true if this Int8 is not null.
+func (i Int8) IsValid() bool {
+	return i.Valid
+}
+
+// IsZero returns true for null Int8s and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Int8 from a valid zero one.
+func (i Int8) IsZero() bool {
+	return !i.Valid || i.Int8 == 0
+}
@@ -0,0 +1,222 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var (
+	int8JSON = []byte(`123`)
+)
+
+func TestInt8From(t *testing.T) {
+	i := Int8From(123)
+	assertInt8(t, i, "Int8From()")
+
+	zero := Int8From(0)
+	if !zero.Valid {
+		t.Error("Int8From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestInt8FromPtr(t *testing.T) {
+	n := int8(123)
+	i := Int8FromPtr(&n)
+	assertInt8(t, i, "Int8FromPtr()")
+
+	null := Int8FromPtr(nil)
+	assertNullInt8(t, null, "Int8FromPtr(nil)")
+}
+
+func TestUnmarshalInt8(t *testing.T) {
+	var i Int8
+	err := json.Unmarshal(int8JSON, &i)
+	maybePanic(err)
+	assertInt8(t, i, "int8 json")
+
+	var null Int8
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt8(t, null, "null json")
+
+	var badType Int8
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt8(t, badType, "wrong type json")
+
+	var nonInteger Int8
+	err = json.Unmarshal(floatJSON, &nonInteger)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt8(t, nonInteger, "non-integer json")
+}
+
+func TestUnmarshalInt8Bounds(t *testing.T) {
+	var i Int8
+	err := json.Unmarshal([]byte(strconv.FormatInt(math.MaxInt8, 10)), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int8 != math.MaxInt8 {
+		t.Errorf("bad max int8 json: %d (valid: %t)\n", i.Int8, i.Valid)
+	}
+
+	err = json.Unmarshal([]byte(strconv.FormatInt(math.MinInt8, 10)), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int8 != math.MinInt8 {
+		t.Errorf("bad min int8 json: %d (valid: %t)\n", i.Int8, i.Valid)
+	}
+
+	for _, s := range []string{strconv.FormatInt(math.MaxInt8+1, 10), strconv.FormatInt(math.MinInt8-1, 10)} {
+		err = json.Unmarshal([]byte(s), &i)
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		} else if !strings.Contains(err.Error(), strconv.FormatInt(math.MaxInt8, 10)) {
+			t.Errorf("error for %s should mention the bound: %v", s, err)
+		}
+		assertNullInt8(t, i, "overflowing json")
+
+		err = i.UnmarshalText([]byte(s))
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullInt8(t, i, "overflowing text")
+	}
+}
+
+func TestTextUnmarshalInt8(t *testing.T) {
+	var i Int8
+	err := i.UnmarshalText([]byte("123"))
+	maybePanic(err)
+	assertInt8(t, i, "UnmarshalText() int8")
+
+	var blank Int8
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullInt8(t, blank, "UnmarshalText() empty int8")
+
+	var null Int8
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullInt8(t, null, `UnmarshalText() "null"`)
+}
+
+func TestMarshalInt8(t *testing.T) {
+	i := Int8From(123)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty json marshal")
+
+	data, err = i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty text marshal")
+
+	null := NewInt8(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestInt8Pointer(t *testing.T) {
+	i := Int8From(123)
+	ptr := i.Ptr()
+	if *ptr != 123 {
+		t.Errorf("bad %s int8: %#v ≠ %d\n", "pointer", ptr, 123)
+	}
+
+	null := NewInt8(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s int8: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestInt8IsZero(t *testing.T) {
+	i := Int8From(123)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewInt8(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := NewInt8(0, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestInt8ValueOrZero(t *testing.T) {
+	valid := Int8From(123)
+	if valid.ValueOrZero() != 123 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewInt8(123, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestInt8SetValid(t *testing.T) {
+	change := NewInt8(0, false)
+	assertNullInt8(t, change, "SetValid()")
+	change.SetValid(123)
+	assertInt8(t, change, "SetValid()")
+}
+
+func TestInt8Scan(t *testing.T) {
+	var i Int8
+	err := i.Scan(int64(123))
+	maybePanic(err)
+	assertInt8(t, i, "scanned int8")
+
+	v, err := i.Value()
+	maybePanic(err)
+	if v != int64(123) {
+		t.Errorf("bad int8 value: %v ≠ %v\n", v, 123)
+	}
+
+	var null Int8
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullInt8(t, null, "scanned null")
+
+	var big Int8
+	err = big.Scan(int64(math.MaxInt8) + 1)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt8(t, big, "scanned overflow")
+}
+
+func assertInt8(t *testing.T, i Int8, from string) {
+	if i.Int8 != 123 {
+		t.Errorf("bad %s int8: %d ≠ %d\n", from, i.Int8, 123)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullInt8(t *testing.T, i Int8, from string) {
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
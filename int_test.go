@@ -1,9 +1,13 @@
 package null
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -32,6 +36,42 @@ func TestIntFromPtr(t *testing.T) {
 	assertNullInt(t, null, "IntFromPtr(nil)")
 }
 
+func TestIntFromSQL(t *testing.T) {
+	v := IntFromSQL(sql.NullInt64{Int64: 12345, Valid: true})
+	assertInt(t, v, "IntFromSQL()")
+	if v.NullInt64 != (sql.NullInt64{Int64: 12345, Valid: true}) {
+		t.Errorf("bad NullInt64 round trip: %v", v.NullInt64)
+	}
+
+	null := IntFromSQL(sql.NullInt64{})
+	assertNullInt(t, null, "IntFromSQL() null")
+	if null.NullInt64 != (sql.NullInt64{}) {
+		t.Errorf("bad null NullInt64 round trip: %v", null.NullInt64)
+	}
+}
+
+func TestIntFromString(t *testing.T) {
+	i, err := IntFromString("12345")
+	maybePanic(err)
+	assertInt(t, i, "IntFromString()")
+
+	null, err := IntFromString("")
+	maybePanic(err)
+	assertNullInt(t, null, "IntFromString(\"\")")
+
+	invalid, err := IntFromString("12.5")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt(t, invalid, "IntFromString() invalid")
+}
+
+func TestMustInt(t *testing.T) {
+	assertInt(t, MustInt("12345"), "MustInt()")
+	assertNullInt(t, MustInt(""), "MustInt(\"\")")
+	assertPanics(t, func() { MustInt("12.5") }, "MustInt() invalid")
+}
+
 func TestUnmarshalInt(t *testing.T) {
 	var i Int
 	err := json.Unmarshal(intJSON, &i)
@@ -43,6 +83,11 @@ func TestUnmarshalInt(t *testing.T) {
 	maybePanic(err)
 	assertInt(t, ni, "sq.NullInt64 json")
 
+	invalidObj := IntFrom(12345)
+	err = json.Unmarshal([]byte(`{"Int64":12345,"Valid":false}`), &invalidObj)
+	maybePanic(err)
+	assertNullInt(t, invalidObj, "sql.NullInt64 json with Valid false")
+
 	var null Int
 	err = json.Unmarshal(nullJSON, &null)
 	maybePanic(err)
@@ -56,6 +101,75 @@ func TestUnmarshalInt(t *testing.T) {
 	assertNullInt(t, badType, "wrong type json")
 }
 
+func TestIntUnmarshalJSONStrict(t *testing.T) {
+	var v Int
+	err := v.UnmarshalJSONStrict(intJSON)
+	maybePanic(err)
+	assertInt(t, v, "strict json")
+
+	var null Int
+	if err := null.UnmarshalJSONStrict(nullJSON); err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt(t, null, "strict null json")
+}
+
+func TestIntUnmarshalJSONValidate(t *testing.T) {
+	validate := func(v int64) error {
+		if v > 12345 {
+			return errTooBig
+		}
+		return nil
+	}
+
+	var v Int
+	err := v.UnmarshalJSONValidate(intJSON, validate)
+	assertValidated(t, err, nil, "validated json")
+	assertInt(t, v, "validated json")
+
+	var invalid Int
+	err = invalid.UnmarshalJSONValidate([]byte(`12346`), validate)
+	assertValidated(t, err, errTooBig, "invalid json")
+	assertNullInt(t, invalid, "invalid json")
+
+	var null Int
+	err = null.UnmarshalJSONValidate(nullJSON, func(int64) error { return errTooBig })
+	assertValidated(t, err, nil, "null json")
+	assertNullInt(t, null, "null json")
+}
+
+func TestUnmarshalIntQuoted(t *testing.T) {
+	var quoted Int
+	err := json.Unmarshal([]byte(`"12345"`), &quoted)
+	maybePanic(err)
+	assertInt(t, quoted, "quoted int json")
+
+	var bare Int
+	err = json.Unmarshal([]byte(`12345`), &bare)
+	maybePanic(err)
+	assertInt(t, bare, "bare int json")
+
+	big := IntFrom(1)
+	err = json.Unmarshal([]byte(`"9223372036854775807"`), &big)
+	maybePanic(err)
+	if !big.Valid || big.Int64 != math.MaxInt64 {
+		t.Errorf("quoted max int64 lost precision: %v", big)
+	}
+
+	blank := IntFrom(12345)
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullInt(t, blank, "blank string json")
+
+	for _, in := range []string{`"test"`, `"3.14"`, `"+1"`, `" 1"`, `"0x10"`, `"1_000"`, `"9223372036854775808"`} {
+		bad := IntFrom(12345)
+		if err := json.Unmarshal([]byte(in), &bad); err == nil {
+			t.Errorf("expected error for %s", in)
+		}
+		assertNullInt(t, bad, "bad quoted json "+in)
+	}
+}
+
 func TestUnmarshalNonIntegerNumber(t *testing.T) {
 	var i Int
 	err := json.Unmarshal(floatJSON, &i)
@@ -80,6 +194,58 @@ func TestUnmarshalInt64Overflow(t *testing.T) {
 	}
 }
 
+func TestUnmarshalIntExact(t *testing.T) {
+	var i Int
+	err := json.Unmarshal([]byte(`9223372036854775807`), &i)
+	maybePanic(err)
+	if !i.Valid || i.Int64 != math.MaxInt64 {
+		t.Errorf("bad max int64: %d ≠ %d\n", i.Int64, int64(math.MaxInt64))
+	}
+
+	// a number that float64 can't represent exactly
+	var odd Int
+	err = json.Unmarshal([]byte(`9007199254740993`), &odd)
+	maybePanic(err)
+	if odd.Int64 != 9007199254740993 {
+		t.Errorf("bad odd int64: %d ≠ %d\n", odd.Int64, int64(9007199254740993))
+	}
+
+	var record struct {
+		I Int
+	}
+	dec := json.NewDecoder(strings.NewReader(`{"I": -9223372036854775808}`))
+	dec.UseNumber()
+	err = dec.Decode(&record)
+	maybePanic(err)
+	if !record.I.Valid || record.I.Int64 != math.MinInt64 {
+		t.Errorf("bad UseNumber int64: %d ≠ %d\n", record.I.Int64, int64(math.MinInt64))
+	}
+}
+
+func TestUnmarshalIntOverflow(t *testing.T) {
+	var min Int
+	err := json.Unmarshal([]byte(`-9223372036854775808`), &min)
+	maybePanic(err)
+	if !min.Valid || min.Int64 != math.MinInt64 {
+		t.Errorf("bad min int64: %d ≠ %d\n", min.Int64, int64(math.MinInt64))
+	}
+
+	for _, data := range []string{`9223372036854775808`, `-9223372036854775809`, `1e19`} {
+		i := IntFrom(12345)
+		err := json.Unmarshal([]byte(data), &i)
+		if err == nil {
+			t.Errorf("expected error for %s", data)
+		}
+		assertNullInt(t, i, data)
+	}
+
+	var i Int
+	err = json.Unmarshal([]byte(`9223372036854775808`), &i)
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("expected a range error, got %v", err)
+	}
+}
+
 func TestTextUnmarshalInt(t *testing.T) {
 	var i Int
 	err := i.UnmarshalText([]byte("12345"))
@@ -147,10 +313,189 @@ func TestIntIsZero(t *testing.T) {
 	if !null.IsZero() {
 		t.Errorf("IsZero() should be true")
 	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
 
 	zero := NewInt(0, true)
-	if zero.IsZero() {
-		t.Errorf("IsZero() should be false")
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestIntValueOrZero(t *testing.T) {
+	valid := IntFrom(12345)
+	if valid.ValueOrZero() != 12345 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewInt(12345, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestIntCompare(t *testing.T) {
+	for _, tc := range []struct {
+		a, b       Int
+		want, last int
+	}{
+		{IntFrom(-1), IntFrom(1), -1, -1},
+		{IntFrom(1), IntFrom(-1), 1, 1},
+		{IntFrom(1), IntFrom(1), 0, 0},
+		{NewInt(0, false), IntFrom(0), -1, 1},
+		{IntFrom(math.MinInt64), NewInt(0, false), 1, -1},
+		{NewInt(1, false), NewInt(2, false), 0, 0},
+	} {
+		if got := tc.a.Compare(tc.b); got != tc.want {
+			t.Errorf("Compare(%v, %v) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+		NullsLast = true
+		if got := tc.a.Compare(tc.b); got != tc.last {
+			t.Errorf("Compare(%v, %v) with NullsLast = %d, want %d", tc.a, tc.b, got, tc.last)
+		}
+		NullsLast = false
+	}
+}
+
+func TestIntEqual(t *testing.T) {
+	a := NewInt(12345, false)
+	b := NewInt(12345, false)
+	assertIntEqualIsTrue(t, a, b)
+
+	a = NewInt(12345, false)
+	b = NewInt(54321, false)
+	assertIntEqualIsTrue(t, a, b)
+
+	a = NewInt(12345, true)
+	b = NewInt(12345, true)
+	assertIntEqualIsTrue(t, a, b)
+
+	a = NewInt(12345, true)
+	b = NewInt(12345, false)
+	assertIntEqualIsFalse(t, a, b)
+
+	a = NewInt(12345, true)
+	b = NewInt(54321, true)
+	assertIntEqualIsFalse(t, a, b)
+}
+
+func TestIntValueOr(t *testing.T) {
+	if v := IntFrom(12345).ValueOr(8080); v != 12345 {
+		t.Error("unexpected ValueOr", v)
+	}
+	if v := IntFrom(0).ValueOr(8080); v != 0 {
+		t.Error("unexpected ValueOr for valid zero Int", v)
+	}
+	if v := NewInt(12345, false).ValueOr(8080); v != 8080 {
+		t.Error("unexpected ValueOr", v)
+	}
+}
+
+func TestIntOr(t *testing.T) {
+	valid := IntFrom(12345)
+	null := NewInt(1, false)
+	assertInt(t, valid.Or(IntFrom(1)), "valid.Or()")
+	assertInt(t, null.Or(valid), "null.Or(valid)")
+	assertNullInt(t, null.Or(null), "null.Or(null)")
+	assertInt(t, null.Or(null).Or(valid).Or(IntFrom(1)), "chained Or()")
+}
+
+func TestIntArithmetic(t *testing.T) {
+	a, b, null := IntFrom(12340), IntFrom(5), NewInt(0, false)
+	assertInt(t, a.Add(b), "Add()")
+	assertInt(t, IntFrom(12350).Sub(b), "Sub()")
+	assertInt(t, IntFrom(2469).Mul(b), "Mul()")
+	if got := a.Mul(IntFrom(0)); got != IntFrom(0) {
+		t.Errorf("bad Mul() by zero: %v", got)
+	}
+
+	for _, pair := range [][2]Int{{a, null}, {null, a}, {null, null}} {
+		x, y := pair[0], pair[1]
+		for op, got := range map[string]Int{"Add": x.Add(y), "Sub": x.Sub(y), "Mul": x.Mul(y)} {
+			if got != (Int{}) {
+				t.Errorf("%s(%v, %v) should be null, got %v", op, x, y, got)
+			}
+		}
+	}
+
+	max, min, one := IntFrom(math.MaxInt64), IntFrom(math.MinInt64), IntFrom(1)
+	overflows := map[string]Int{
+		"MaxInt64 + 1":  max.Add(one),
+		"MinInt64 - 1":  min.Sub(one),
+		"MinInt64 + -1": min.Add(IntFrom(-1)),
+		"-2 - MaxInt64": IntFrom(-2).Sub(max),
+		"MaxInt64 * 2":  max.Mul(IntFrom(2)),
+		"MinInt64 * -1": min.Mul(IntFrom(-1)),
+		"-1 * MinInt64": IntFrom(-1).Mul(min),
+	}
+	for op, got := range overflows {
+		if got != (Int{}) {
+			t.Errorf("%s should overflow to null, got %v", op, got)
+		}
+	}
+	if got := max.Sub(max); got != IntFrom(0) {
+		t.Errorf("bad MaxInt64 - MaxInt64: %v", got)
+	}
+	if got := min.Add(max); got != IntFrom(-1) {
+		t.Errorf("bad MinInt64 + MaxInt64: %v", got)
+	}
+}
+
+func TestIntMap(t *testing.T) {
+	assertInt(t, IntFrom(2469).Map(func(n int64) int64 { return n * 5 }), "Map()")
+
+	called := false
+	null := NewInt(2469, false).Map(func(v int64) int64 {
+		called = true
+		return v
+	})
+	assertNullInt(t, null, "null Map()")
+	if called {
+		t.Error("Map() should not call f for a null Int")
+	}
+}
+
+func TestIntToBool(t *testing.T) {
+	assertBool(t, IntFrom(1).ToBool(), "IntFrom(1).ToBool()")
+	if b := IntFrom(0).ToBool(); !b.Valid || b.Bool {
+		t.Errorf("IntFrom(0).ToBool() = %v, want false", b)
+	}
+	assertNullBool(t, IntFrom(2).ToBool(), "IntFrom(2).ToBool()")
+	assertNullBool(t, IntFrom(-1).ToBool(), "IntFrom(-1).ToBool()")
+	assertNullBool(t, NewInt(1, false).ToBool(), "null ToBool()")
+}
+
+func TestIntToString(t *testing.T) {
+	for _, tc := range []struct {
+		in   Int
+		want String
+	}{
+		{IntFrom(12345), StringFrom("12345")},
+		{IntFrom(-1), StringFrom("-1")},
+		{NewInt(12345, false), NewString("", false)},
+	} {
+		if got := tc.in.ToString(); got != tc.want {
+			t.Errorf("%v.ToString() = %#v, want %#v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestIntString(t *testing.T) {
+	i := IntFrom(12345)
+	if i.String() != "12345" {
+		t.Errorf("bad String() output: %s\n", i.String())
+	}
+	if out := fmt.Sprintf("%v", i); out != "12345" {
+		t.Errorf("bad fmt output: %s\n", out)
+	}
+
+	null := NewInt(12345, false)
+	if null.String() != "null" {
+		t.Errorf("bad null String() output: %s\n", null.String())
 	}
 }
 
@@ -161,6 +506,39 @@ func TestIntSetValid(t *testing.T) {
 	assertInt(t, change, "SetValid()")
 }
 
+func TestIntSet(t *testing.T) {
+	var i Int
+	i.Set(12345, true)
+	assertInt(t, i, "Set(x, true)")
+
+	i.Set(12345, false)
+	assertNullInt(t, i, "Set(x, false)")
+	if i.Int64 != 12345 {
+		t.Errorf("Set(x, false) should keep the value, got %v", i.Int64)
+	}
+}
+
+func TestIntSetNull(t *testing.T) {
+	i := IntFrom(12345)
+	i.SetNull()
+	assertNullInt(t, i, "SetNull()")
+	if i.Int64 != 0 {
+		t.Errorf("SetNull() should reset the value, got %v", i.Int64)
+	}
+}
+
+func TestIntSetValidIf(t *testing.T) {
+	var i Int
+	i.SetValidIf(12345, true)
+	assertInt(t, i, "SetValidIf(x, true)")
+
+	i.SetValidIf(12345, false)
+	assertNullInt(t, i, "SetValidIf(x, false)")
+	if i.Int64 != 0 {
+		t.Errorf("SetValidIf(x, false) should reset the value, got %v", i.Int64)
+	}
+}
+
 func TestIntScan(t *testing.T) {
 	var i Int
 	err := i.Scan(12345)
@@ -171,6 +549,68 @@ func TestIntScan(t *testing.T) {
 	err = null.Scan(nil)
 	maybePanic(err)
 	assertNullInt(t, null, "scanned null")
+
+	var f Int
+	err = f.Scan(float64(12345))
+	maybePanic(err)
+	assertInt(t, f, "scanned whole float64")
+
+	for _, v := range []float64{3.5, math.NaN(), 1e19, -1e19, math.Inf(1)} {
+		bad := IntFrom(12345)
+		if err := bad.Scan(v); err == nil {
+			t.Errorf("expected error scanning %v", v)
+		}
+		assertNullInt(t, bad, "scanned bad float64")
+	}
+}
+
+func TestIntScanText(t *testing.T) {
+	for _, tc := range []struct {
+		src  interface{}
+		want int64
+	}{
+		{"12345", 12345},
+		{[]byte(" 12345 "), 12345},
+		{"\t-42\n", -42},
+		{"+42", 42},
+		{"1_000", 1000},
+		{[]byte("-1_000_000"), -1000000},
+		{"010", 10},
+	} {
+		var i Int
+		err := i.Scan(tc.src)
+		maybePanic(err)
+		if !i.Valid || i.Int64 != tc.want {
+			t.Errorf("Scan(%q) = %d (valid: %t), want %d", tc.src, i.Int64, i.Valid, tc.want)
+		}
+	}
+
+	for _, src := range []string{"", "  ", "12a", "1.5", "0x10", "_1", "1_", "1__0", "-_1", "9223372036854775808"} {
+		bad := IntFrom(12345)
+		if err := bad.Scan(src); err == nil {
+			t.Errorf("expected error scanning %q", src)
+		}
+		assertNullInt(t, bad, "scanned malformed string")
+	}
+}
+
+type namedInt int
+
+func TestIntScanNamed(t *testing.T) {
+	type namedUint uint16
+	type namedFloat float32
+	for _, src := range []interface{}{namedInt(12345), namedUint(12345), namedFloat(12345), namedString(" 12345 ")} {
+		var i Int
+		err := i.Scan(src)
+		maybePanic(err)
+		assertInt(t, i, fmt.Sprintf("scanned %T", src))
+	}
+
+	bad := IntFrom(12345)
+	if err := bad.Scan(namedFloat(1.5)); err == nil {
+		t.Error("expected error scanning a fractional named float")
+	}
+	assertNullInt(t, bad, "scanned fractional named float")
 }
 
 func assertInt(t *testing.T, i Int, from string) {
@@ -187,3 +627,17 @@ func assertNullInt(t *testing.T, i Int, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func assertIntEqualIsTrue(t *testing.T, a, b Int) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Int{%v, Valid:%t} and Int{%v, Valid:%t} should return true", a.Int64, a.Valid, b.Int64, b.Valid)
+	}
+}
+
+func assertIntEqualIsFalse(t *testing.T, a, b Int) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Int{%v, Valid:%t} and Int{%v, Valid:%t} should return false", a.Int64, a.Valid, b.Int64, b.Valid)
+	}
+}
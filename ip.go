@@ -0,0 +1,204 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+)
+
+// IP is a nullable net.IP. It supports SQL and JSON serialization.
+// It will marshal to null if null, and to the textual form such as "192.168.1.1" or "::1" otherwise.
+// Blank string input will be considered null, but unparseable input is an error.
+type IP struct {
+	IP    net.IP
+	Valid bool
+}
+
+// NewIP creates a new IP
+func NewIP(ip net.IP, valid bool) IP {
+	return IP{
+		IP:    ip,
+		Valid: valid,
+	}
+}
+
+// IPFrom creates a new IP that will be valid unless ip is nil.
+func IPFrom(ip net.IP) IP {
+	return NewIP(ip, ip != nil)
+}
+
+// IPFromPtr creates a new IP that will be null if ip is nil.
+func IPFromPtr(ip *net.IP) IP {
+	if ip == nil {
+		return NewIP(nil, false)
+	}
+	return IPFrom(*ip)
+}
+
+// IPFromString creates a new IP by parsing s.
+// It will be null if s is blank, and an error is returned if s is not a valid IP address.
+func IPFromString(s string) (IP, error) {
+	var ip IP
+	err := ip.UnmarshalText([]byte(s))
+	return ip, err
+}
+
+// parseIP parses an IP address, also accepting the address/prefix form
+// that Postgres uses for inet values, as long as the prefix covers the whole address,
+// such as "10.0.0.1/32". An IP has nowhere to keep a shorter network prefix,
+// so rather than silently dropping it, input such as "10.0.0.1/8" is an error.
+func parseIP(s string) (net.IP, error) {
+	if strings.IndexByte(s, '/') != -1 {
+		ip, ipnet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("null: invalid IP: %q", s)
+		}
+		if ones, bits := ipnet.Mask.Size(); ones != bits {
+			return nil, fmt.Errorf("null: cannot use %q as a null.IP: it has a /%d network prefix, and only host addresses are supported", s, ones)
+		}
+		return ip, nil
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("null: invalid IP: %q", s)
+	}
+	return ip, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports IP address strings and null input. Blank string input produces a null IP.
+func (ip *IP) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.IP")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		return ip.UnmarshalText([]byte(x))
+	case nil:
+		ip.Valid = false
+		return nil
+	}
+	ip.Valid = false
+	return fmt.Errorf("json: cannot unmarshal %v into Go value of type null.IP", reflect.TypeOf(v).Name())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null IP if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not an IP address.
+func (ip *IP) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		ip.Valid = false
+		return nil
+	}
+	var err error
+	ip.IP, err = parseIP(str)
+	ip.Valid = err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this IP is null.
+func (ip IP) MarshalJSON() ([]byte, error) {
+	if !ip.Valid || ip.IP == nil {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + ip.IP.String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this IP is null.
+func (ip IP) MarshalText() ([]byte, error) {
+	if !ip.Valid || ip.IP == nil {
+		return nullText(), nil
+	}
+	return []byte(ip.IP.String()), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports IP address strings and []byte, including Postgres inet text such as "10.0.0.1/32".
+// Inet values with a shorter network prefix, such as "10.0.0.1/8", are an error,
+// since Value couldn't write the prefix back.
+func (ip *IP) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case []byte:
+		return ip.UnmarshalText(x)
+	case string:
+		return ip.UnmarshalText([]byte(x))
+	case nil:
+		ip.Valid = false
+		return nil
+	}
+	ip.Valid = false
+	return fmt.Errorf("null: cannot scan type %T into null.IP: %v", value, value)
+}
+
+// Value implements driver.Valuer.
+// It returns the textual form of this IP, or nil if this IP is null.
+func (ip IP) Value() (driver.Value, error) {
+	if !ip.Valid || ip.IP == nil {
+		return nil, nil
+	}
+	return ip.IP.String(), nil
+}
+
+// SetValid changes this IP's value and also sets it to be non-null.
+func (ip *IP) SetValid(v net.IP) {
+	ip.IP = v
+	ip.Valid = true
+}
+
+// SetNull sets this IP to null, resetting its value to zero.
+func (ip *IP) SetNull() {
+	ip.IP = nil
+	ip.Valid = false
+}
+
+// Ptr returns a pointer to this IP's value, or a nil pointer if this IP is null.
+func (ip IP) Ptr() *net.IP {
+	if !ip.Valid {
+		return nil
+	}
+	return &ip.IP
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (ip IP) ValueOrZero() net.IP {
+	if !ip.Valid {
+		return nil
+	}
+	return ip.IP
+}
+
+// String implements fmt.Stringer.
+// It returns the textual form of this IP, or NullDisplay if null.
+func (ip IP) String() string {
+	if !ip.Valid || ip.IP == nil {
+		return NullDisplay
+	}
+	return ip.IP.String()
+}
+
+// IsNull returns true if this IP is null.
+func (ip IP) IsNull() bool {
+	return !ip.Valid
+}
+
+// Null is the same as IsNull.
+func (ip IP) Null() bool {
+	return !ip.Valid
+}
+
+// IsValid returns true if this IP is not null.
+func (ip IP) IsValid() bool {
+	return ip.Valid
+}
+
+// IsZero returns true for null IPs and for valid ones holding an empty IP, for omitempty support.
+// Use IsValid to tell a null IP from a valid zero one.
+func (ip IP) IsZero() bool {
+	return !ip.Valid || len(ip.IP) == 0
+}
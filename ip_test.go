@@ -0,0 +1,245 @@
+package null
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+)
+
+var (
+	ipv4String = "192.168.1.1"
+	ipv4JSON   = []byte(`"` + ipv4String + `"`)
+	ipv4Value  = net.IPv4(192, 168, 1, 1)
+	ipv6String = "::1"
+	ipv6JSON   = []byte(`"` + ipv6String + `"`)
+	ipv6Value  = net.IPv6loopback
+)
+
+func TestIPFrom(t *testing.T) {
+	ip := IPFrom(ipv4Value)
+	assertIP(t, ip, ipv4Value, "IPFrom()")
+
+	null := IPFrom(nil)
+	assertNullIP(t, null, "IPFrom(nil)")
+}
+
+func TestIPFromPtr(t *testing.T) {
+	v := ipv4Value
+	ip := IPFromPtr(&v)
+	assertIP(t, ip, ipv4Value, "IPFromPtr()")
+
+	null := IPFromPtr(nil)
+	assertNullIP(t, null, "IPFromPtr(nil)")
+}
+
+func TestIPFromString(t *testing.T) {
+	v4, err := IPFromString(ipv4String)
+	maybePanic(err)
+	assertIP(t, v4, ipv4Value, "IPFromString() IPv4")
+
+	v6, err := IPFromString(ipv6String)
+	maybePanic(err)
+	assertIP(t, v6, ipv6Value, "IPFromString() IPv6")
+
+	null, err := IPFromString("")
+	maybePanic(err)
+	assertNullIP(t, null, "IPFromString(\"\")")
+
+	invalid, err := IPFromString("256.0.0.1")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullIP(t, invalid, "IPFromString() invalid")
+}
+
+func TestUnmarshalIP(t *testing.T) {
+	var v4 IP
+	err := json.Unmarshal(ipv4JSON, &v4)
+	maybePanic(err)
+	assertIP(t, v4, ipv4Value, "IPv4 json")
+
+	var v6 IP
+	err = json.Unmarshal(ipv6JSON, &v6)
+	maybePanic(err)
+	assertIP(t, v6, ipv6Value, "IPv6 json")
+
+	var blank IP
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullIP(t, blank, "blank string json")
+
+	var null IP
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullIP(t, null, "null json")
+
+	var invalid IP
+	err = json.Unmarshal(stringJSON, &invalid)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullIP(t, invalid, "invalid string json")
+
+	var badType IP
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullIP(t, badType, "wrong type json")
+}
+
+func TestMarshalIP(t *testing.T) {
+	data, err := json.Marshal(IPFrom(ipv4Value))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(ipv4JSON), "IPv4 json marshal")
+
+	data, err = json.Marshal(IPFrom(ipv6Value))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(ipv6JSON), "IPv6 json marshal")
+
+	data, err = IPFrom(ipv4Value).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, ipv4String, "non-empty text marshal")
+
+	null := IPFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestIPScan(t *testing.T) {
+	var s IP
+	err := s.Scan(ipv4String)
+	maybePanic(err)
+	assertIP(t, s, ipv4Value, "scanned string")
+
+	var b IP
+	err = b.Scan([]byte(ipv6String))
+	maybePanic(err)
+	assertIP(t, b, ipv6Value, "scanned []byte")
+
+	var inet IP
+	err = inet.Scan([]byte("192.168.1.1/32"))
+	maybePanic(err)
+	assertIP(t, inet, ipv4Value, "scanned inet")
+
+	var inet6 IP
+	err = inet6.Scan(ipv6String + "/128")
+	maybePanic(err)
+	assertIP(t, inet6, ipv6Value, "scanned IPv6 inet")
+
+	for _, in := range []string{"10.0.0.1/8", ipv6String + "/64", "10.0.0.1/33"} {
+		prefixed := IPFrom(ipv4Value)
+		err = prefixed.Scan(in)
+		if err == nil {
+			t.Errorf("expected error scanning %q, got %v", in, prefixed.IP)
+		}
+		assertNullIP(t, prefixed, "scanned "+in)
+	}
+
+	var null IP
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullIP(t, null, "scanned null")
+
+	var invalid IP
+	err = invalid.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullIP(t, invalid, "scanned invalid")
+
+	var wrong IP
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullIP(t, wrong, "scanned int64")
+
+	v, err := s.Value()
+	maybePanic(err)
+	if v != ipv4String {
+		t.Errorf("bad ip value: %v ≠ %v\n", v, ipv4String)
+	}
+
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null ip value: %v ≠ nil\n", v)
+	}
+}
+
+func TestIPPointer(t *testing.T) {
+	ip := IPFrom(ipv4Value)
+	ptr := ip.Ptr()
+	if !ptr.Equal(ipv4Value) {
+		t.Errorf("bad %s ip: %#v ≠ %v\n", "pointer", ptr, ipv4Value)
+	}
+
+	null := NewIP(ipv4Value, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s ip: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestIPValueOrZero(t *testing.T) {
+	valid := IPFrom(ipv4Value)
+	if !valid.ValueOrZero().Equal(ipv4Value) {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewIP(ipv4Value, false)
+	if invalid.ValueOrZero() != nil {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestIPIsZero(t *testing.T) {
+	ip := IPFrom(ipv4Value)
+	if ip.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := IPFromPtr(nil)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := IPFrom(net.IP{})
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestIPSetValid(t *testing.T) {
+	var change IP
+	assertNullIP(t, change, "SetValid()")
+	change.SetValid(ipv4Value)
+	assertIP(t, change, ipv4Value, "SetValid()")
+}
+
+func assertIP(t *testing.T, ip IP, expected net.IP, from string) {
+	if !ip.IP.Equal(expected) {
+		t.Errorf("bad %s ip: %v ≠ %v\n", from, ip.IP, expected)
+	}
+	if !ip.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullIP(t *testing.T, ip IP, from string) {
+	if ip.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
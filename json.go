@@ -0,0 +1,156 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// JSON is a nullable JSON document, for columns such as Postgres jsonb or MySQL json.
+// It holds the raw encoded document, which may be any JSON value.
+// It will marshal to null if null, and to the raw document otherwise.
+type JSON struct {
+	JSON  json.RawMessage
+	Valid bool
+}
+
+// NewJSON creates a new JSON
+func NewJSON(b []byte, valid bool) JSON {
+	return JSON{
+		JSON:  b,
+		Valid: valid,
+	}
+}
+
+// JSONFrom creates a new JSON that will be null if b is nil.
+func JSONFrom(b []byte) JSON {
+	return NewJSON(b, b != nil)
+}
+
+// JSONFromPtr creates a new JSON that will be null if b is nil.
+func JSONFromPtr(b *[]byte) JSON {
+	if b == nil {
+		return NewJSON(nil, false)
+	}
+	return JSONFrom(*b)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports any JSON input. null input produces a null JSON.
+func (j *JSON) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.JSON")
+	if kindOf(bytes.TrimSpace(data)) == jsonNull {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+	j.JSON = append(j.JSON[0:0], data...)
+	j.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this JSON is null, or if it is valid but holds an empty document,
+// which would otherwise be invalid output.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if !j.Valid || len(bytes.TrimSpace(j.JSON)) == 0 {
+		return []byte("null"), nil
+	}
+	return j.JSON, nil
+}
+
+// Unmarshal decodes the contained document into v.
+// A null JSON decodes as JSON null.
+func (j JSON) Unmarshal(v interface{}) error {
+	if !j.Valid {
+		return json.Unmarshal([]byte("null"), v)
+	}
+	return json.Unmarshal(j.JSON, v)
+}
+
+// Marshal encodes v and stores it as the contained document.
+// If v encodes to JSON null, this JSON becomes null.
+func (j *JSON) Marshal(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return j.UnmarshalJSON(data)
+}
+
+// Scan implements sql.Scanner.
+// It supports []byte, string, and nil values, which must hold a valid JSON document.
+// A JSON null document produces a null JSON. The document is copied,
+// as database/sql may reuse the driver's buffer.
+func (j *JSON) Scan(value interface{}) error {
+	var doc json.RawMessage
+	valid, err := scanJSON(&doc, value)
+	if err != nil {
+		j.Valid = false
+		return fmt.Errorf("null: cannot scan into null.JSON: %w", err)
+	}
+	if !valid {
+		doc = nil
+	}
+	j.JSON, j.Valid = doc, valid
+	return nil
+}
+
+// Value implements driver.Valuer.
+// It returns the document as []byte, or nil if this JSON is null.
+func (j JSON) Value() (driver.Value, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+	return []byte(j.JSON), nil
+}
+
+// SetValid changes this JSON's value and also sets it to be non-null.
+func (j *JSON) SetValid(b []byte) {
+	j.JSON = b
+	j.Valid = true
+}
+
+// SetNull sets this JSON to null, resetting its value to zero.
+func (j *JSON) SetNull() {
+	j.JSON = nil
+	j.Valid = false
+}
+
+// Ptr returns a pointer to this JSON's value, or a nil pointer if this JSON is null.
+func (j JSON) Ptr() *[]byte {
+	if !j.Valid {
+		return nil
+	}
+	b := []byte(j.JSON)
+	return &b
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (j JSON) ValueOrZero() []byte {
+	if !j.Valid {
+		return nil
+	}
+	return j.JSON
+}
+
+// IsNull returns true if this JSON is null.
+func (j JSON) IsNull() bool {
+	return !j.Valid
+}
+
+// Null is the same as IsNull.
+func (j JSON) Null() bool {
+	return !j.Valid
+}
+
+// IsValid returns true if this JSON is not null.
+func (j JSON) IsValid() bool {
+	return j.Valid
+}
+
+// IsZero returns true for null JSONs and for valid ones holding an empty document, for omitempty support.
+// Use IsValid to tell a null JSON from a valid zero one.
+func (j JSON) IsZero() bool {
+	return !j.Valid || len(j.JSON) == 0
+}
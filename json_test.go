@@ -0,0 +1,213 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var (
+	jsonDocument = []byte(`{"hello":"world","n":[1,2,3]}`)
+)
+
+type jsonDoc struct {
+	Hello string
+	N     []int
+}
+
+func TestJSONFrom(t *testing.T) {
+	j := JSONFrom(jsonDocument)
+	assertJSON(t, j, "JSONFrom()")
+
+	null := JSONFrom(nil)
+	assertNullJSON(t, null, "JSONFrom(nil)")
+}
+
+func TestJSONFromPtr(t *testing.T) {
+	b := jsonDocument
+	j := JSONFromPtr(&b)
+	assertJSON(t, j, "JSONFromPtr()")
+
+	null := JSONFromPtr(nil)
+	assertNullJSON(t, null, "JSONFromPtr(nil)")
+}
+
+func TestUnmarshalJSONDocument(t *testing.T) {
+	var j JSON
+	err := json.Unmarshal(jsonDocument, &j)
+	maybePanic(err)
+	assertJSON(t, j, "object json")
+
+	var str JSON
+	err = json.Unmarshal(stringJSON, &str)
+	maybePanic(err)
+	if !str.Valid || string(str.JSON) != `"test"` {
+		t.Errorf("bad string json: %s (valid: %t)\n", str.JSON, str.Valid)
+	}
+
+	var null JSON
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullJSON(t, null, "null json")
+
+	var inStruct struct{ Doc JSON }
+	err = json.Unmarshal([]byte(`{"Doc":`+string(jsonDocument)+`}`), &inStruct)
+	maybePanic(err)
+	assertJSON(t, inStruct.Doc, "nested json")
+}
+
+func TestMarshalJSONDocument(t *testing.T) {
+	j := JSONFrom(jsonDocument)
+	data, err := json.Marshal(j)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(jsonDocument), "non-empty json marshal")
+
+	null := JSONFrom(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestJSONUnmarshalMarshal(t *testing.T) {
+	var doc jsonDoc
+	err := JSONFrom(jsonDocument).Unmarshal(&doc)
+	maybePanic(err)
+	if doc.Hello != "world" || len(doc.N) != 3 {
+		t.Errorf("bad Unmarshal() document: %v\n", doc)
+	}
+
+	var j JSON
+	err = j.Marshal(doc)
+	maybePanic(err)
+	if !j.Valid || string(j.JSON) != `{"Hello":"world","N":[1,2,3]}` {
+		t.Errorf("bad Marshal() document: %s (valid: %t)\n", j.JSON, j.Valid)
+	}
+
+	err = j.Marshal(nil)
+	maybePanic(err)
+	assertNullJSON(t, j, "Marshal(nil)")
+
+	var ptr *jsonDoc
+	err = JSONFrom(nil).Unmarshal(&ptr)
+	maybePanic(err)
+	if ptr != nil {
+		t.Errorf("bad Unmarshal() of null: %v\n", ptr)
+	}
+}
+
+func TestJSONScan(t *testing.T) {
+	var j JSON
+	err := j.Scan(jsonDocument)
+	maybePanic(err)
+	assertJSON(t, j, "scanned []byte")
+
+	var str JSON
+	err = str.Scan(string(jsonDocument))
+	maybePanic(err)
+	assertJSON(t, str, "scanned string")
+
+	var null JSON
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullJSON(t, null, "scanned null")
+
+	var nullDoc JSON
+	err = nullDoc.Scan([]byte("null"))
+	maybePanic(err)
+	assertNullJSON(t, nullDoc, "scanned JSON null document")
+
+	var malformed JSON
+	err = malformed.Scan([]byte(`{"a":`))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullJSON(t, malformed, "scanned malformed document")
+
+	var wrong JSON
+	err = wrong.Scan(int64(1))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullJSON(t, wrong, "scanned int64")
+}
+
+func TestJSONScanReusedBuffer(t *testing.T) {
+	// database/sql may hand each row's column to Scan in the same driver buffer
+	buf := []byte(`{"row":1}`)
+	var first JSON
+	maybePanic(first.Scan(buf))
+
+	copy(buf, `{"row":2}`)
+	var second JSON
+	maybePanic(second.Scan(buf))
+
+	if string(first.JSON) != `{"row":1}` {
+		t.Errorf("bad first row after scanning the second: %s ≠ %s\n", first.JSON, `{"row":1}`)
+	}
+	if string(second.JSON) != `{"row":2}` {
+		t.Errorf("bad second row: %s ≠ %s\n", second.JSON, `{"row":2}`)
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	v, err := JSONFrom(jsonDocument).Value()
+	maybePanic(err)
+	if b, ok := v.([]byte); !ok || string(b) != string(jsonDocument) {
+		t.Errorf("bad json value: %v\n", v)
+	}
+
+	v, err = JSONFrom(nil).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null json value: %v ≠ nil\n", v)
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	ptr := JSONFrom(jsonDocument).Ptr()
+	if string(*ptr) != string(jsonDocument) {
+		t.Errorf("bad %s json: %s ≠ %s\n", "pointer", *ptr, jsonDocument)
+	}
+
+	ptr = JSONFrom(nil).Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s json: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestJSONSetValid(t *testing.T) {
+	var change JSON
+	assertNullJSON(t, change, "SetValid()")
+	change.SetValid(jsonDocument)
+	assertJSON(t, change, "SetValid()")
+}
+
+func TestJSONIsZero(t *testing.T) {
+	if JSONFrom(jsonDocument).IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := JSONFrom(nil)
+	if !null.IsZero() || null.IsValid() {
+		t.Errorf("null JSON should be zero and invalid")
+	}
+
+	empty := JSONFrom([]byte{})
+	if !empty.IsZero() || !empty.IsValid() {
+		t.Errorf("empty JSON should be zero but valid")
+	}
+}
+
+func assertJSON(t *testing.T, j JSON, from string) {
+	if string(j.JSON) != string(jsonDocument) {
+		t.Errorf("bad %s json: %s ≠ %s\n", from, j.JSON, jsonDocument)
+	}
+	if !j.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullJSON(t *testing.T, j JSON, from string) {
+	if j.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
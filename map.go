@@ -0,0 +1,125 @@
+//go:build go1.18
+
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Map is a nullable map, for JSON object columns where null and {} are different.
+// It supports SQL and JSON serialization.
+// It will marshal to null if null, and to a JSON object otherwise.
+// A nil map is null, but an empty non-nil map is valid: MapFrom(nil) is null
+// while MapFrom(map[K]V{}) is valid and empty.
+// In SQL it is stored as JSON text.
+type Map[K comparable, V any] struct {
+	Map   map[K]V
+	Valid bool
+}
+
+// NewMap creates a new Map.
+func NewMap[K comparable, V any](m map[K]V, valid bool) Map[K, V] {
+	return Map[K, V]{
+		Map:   m,
+		Valid: valid,
+	}
+}
+
+// MapFrom creates a new Map that will be null if m is nil.
+func MapFrom[K comparable, V any](m map[K]V) Map[K, V] {
+	return NewMap(m, m != nil)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports null input, and otherwise any object encoding/json can decode into map[K]V.
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	if kindOf(bytes.TrimSpace(data)) == jsonNull {
+		m.Map, m.Valid = nil, false
+		return nil
+	}
+	var v map[K]V
+	if err := json.Unmarshal(data, &v); err != nil {
+		m.Valid = false
+		return unmarshalError(data, fmt.Sprintf("%T", *m), err)
+	}
+	m.Map, m.Valid = v, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Map is null.
+func (m Map[K, V]) MarshalJSON() ([]byte, error) {
+	if !m.Valid {
+		return []byte("null"), nil
+	}
+	if m.Map == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(m.Map)
+}
+
+// Scan implements sql.Scanner.
+// It decodes JSON text given as []byte or string.
+func (m *Map[K, V]) Scan(value interface{}) error {
+	var v map[K]V
+	valid, err := scanJSON(&v, value)
+	if err != nil {
+		m.Valid = false
+		return fmt.Errorf("null: cannot scan into null.Map: %w", err)
+	}
+	m.Map, m.Valid = v, valid
+	return nil
+}
+
+// Value implements driver.Valuer.
+// It returns this Map encoded as JSON, or nil if this Map is null.
+func (m Map[K, V]) Value() (driver.Value, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+	return m.MarshalJSON()
+}
+
+// SetValid changes this Map's value and also sets it to be non-null.
+func (m *Map[K, V]) SetValid(v map[K]V) {
+	m.Map = v
+	m.Valid = true
+}
+
+// SetNull sets this Map to null, resetting its value to zero.
+func (m *Map[K, V]) SetNull() {
+	m.Map = nil
+	m.Valid = false
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (m Map[K, V]) ValueOrZero() map[K]V {
+	if !m.Valid {
+		return nil
+	}
+	return m.Map
+}
+
+// IsNull returns true if this Map is null.
+func (m Map[K, V]) IsNull() bool {
+	return !m.Valid
+}
+
+// Null is the same as IsNull.
+func (m Map[K, V]) Null() bool {
+	return !m.Valid
+}
+
+// IsValid returns true if this Map is not null.
+func (m Map[K, V]) IsValid() bool {
+	return m.Valid
+}
+
+// IsZero returns true for null Maps and for valid ones holding an empty map, for omitempty support.
+// Use IsValid to tell a null Map from a valid zero one.
+func (m Map[K, V]) IsZero() bool {
+	return !m.Valid || len(m.Map) == 0
+}
@@ -0,0 +1,146 @@
+//go:build go1.18
+
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var _ Nullable = (*Map[string, int])(nil)
+
+var mapJSON = []byte(`{"a":1,"b":2}`)
+
+func TestMapFrom(t *testing.T) {
+	m := MapFrom(map[string]int{"a": 1, "b": 2})
+	assertMap(t, m, "MapFrom()")
+
+	null := MapFrom[string, int](nil)
+	assertNullMap(t, null, "MapFrom(nil)")
+
+	empty := MapFrom(map[string]int{})
+	if !empty.Valid || len(empty.Map) != 0 {
+		t.Errorf("MapFrom({}) should be valid and empty, got %v (valid: %t)", empty.Map, empty.Valid)
+	}
+}
+
+func TestUnmarshalMap(t *testing.T) {
+	var m Map[string, int]
+	err := json.Unmarshal(mapJSON, &m)
+	maybePanic(err)
+	assertMap(t, m, "object json")
+
+	var empty Map[string, int]
+	err = json.Unmarshal([]byte(`{}`), &empty)
+	maybePanic(err)
+	if !empty.Valid || empty.Map == nil || len(empty.Map) != 0 {
+		t.Errorf("{} should be a valid empty map, got %v (valid: %t)", empty.Map, empty.Valid)
+	}
+
+	var null Map[string, int]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullMap(t, null, "null json")
+
+	var badType Map[string, int]
+	err = json.Unmarshal([]byte(`[1,2]`), &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullMap(t, badType, "array json")
+}
+
+func TestMarshalMap(t *testing.T) {
+	data, err := json.Marshal(MapFrom(map[string]int{"a": 1, "b": 2}))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(mapJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(MapFrom(map[string]int{}))
+	maybePanic(err)
+	assertJSONEquals(t, data, "{}", "empty json marshal")
+
+	data, err = json.Marshal(NewMap[string, int](nil, true))
+	maybePanic(err)
+	assertJSONEquals(t, data, "{}", "valid nil json marshal")
+
+	data, err = json.Marshal(MapFrom[string, int](nil))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMapScan(t *testing.T) {
+	var m Map[string, int]
+	err := m.Scan(mapJSON)
+	maybePanic(err)
+	assertMap(t, m, "scanned []byte")
+
+	var s Map[string, int]
+	err = s.Scan(string(mapJSON))
+	maybePanic(err)
+	assertMap(t, s, "scanned string")
+
+	var empty Map[string, int]
+	err = empty.Scan("{}")
+	maybePanic(err)
+	if !empty.Valid || len(empty.Map) != 0 {
+		t.Errorf("scanned {} should be a valid empty map, got %v (valid: %t)", empty.Map, empty.Valid)
+	}
+
+	var null Map[string, int]
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullMap(t, null, "scanned null")
+
+	var wrong Map[string, int]
+	err = wrong.Scan(int64(1))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullMap(t, wrong, "scanned int64")
+
+	v, err := m.Value()
+	maybePanic(err)
+	assertJSONEquals(t, v.([]byte), string(mapJSON), "map value")
+
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null map value: %v ≠ nil\n", v)
+	}
+}
+
+func TestMapValueOrZero(t *testing.T) {
+	valid := MapFrom(map[string]int{"a": 1, "b": 2})
+	if len(valid.ValueOrZero()) != 2 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+	invalid := NewMap(map[string]int{"a": 1}, false)
+	if invalid.ValueOrZero() != nil {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+	if !invalid.IsZero() || valid.IsZero() || !MapFrom(map[string]int{}).IsZero() {
+		t.Error("unexpected IsZero")
+	}
+	if invalid.IsValid() || !MapFrom(map[string]int{}).IsValid() {
+		t.Error("unexpected IsValid")
+	}
+
+	var change Map[string, int]
+	change.SetValid(map[string]int{"a": 1, "b": 2})
+	assertMap(t, change, "SetValid()")
+}
+
+func assertMap(t *testing.T, m Map[string, int], from string) {
+	if len(m.Map) != 2 || m.Map["a"] != 1 || m.Map["b"] != 2 {
+		t.Errorf("bad %s map: %v\n", from, m.Map)
+	}
+	if !m.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullMap(t *testing.T, m Map[string, int], from string) {
+	if m.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,133 @@
+//go:build msgpack
+
+package null
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// This file adds MessagePack support using github.com/vmihailenco/msgpack/v5.
+// It is only built with the msgpack build tag, so the core package has no msgpack dependency.
+
+// msgpackNil reports whether the next value in dec is nil, consuming it if so.
+func msgpackNil(dec *msgpack.Decoder) (bool, error) {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return false, err
+	}
+	if code != msgpcode.Nil {
+		return false, nil
+	}
+	return true, dec.DecodeNil()
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode nil if this String is null.
+func (s String) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !s.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(s.String)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// It supports string and nil input.
+func (s *String) DecodeMsgpack(dec *msgpack.Decoder) error {
+	null, err := msgpackNil(dec)
+	if err != nil || null {
+		s.Valid = false
+		return err
+	}
+	s.String, err = dec.DecodeString()
+	s.Valid = err == nil
+	return err
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode nil if this Int is null.
+func (i Int) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !i.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeInt(i.Int64)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// It supports integer and nil input.
+func (i *Int) DecodeMsgpack(dec *msgpack.Decoder) error {
+	null, err := msgpackNil(dec)
+	if err != nil || null {
+		i.Valid = false
+		return err
+	}
+	i.Int64, err = dec.DecodeInt64()
+	i.Valid = err == nil
+	return err
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode nil if this Float is null.
+func (f Float) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !f.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeFloat64(f.Float64)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// It supports number and nil input.
+func (f *Float) DecodeMsgpack(dec *msgpack.Decoder) error {
+	null, err := msgpackNil(dec)
+	if err != nil || null {
+		f.Valid = false
+		return err
+	}
+	f.Float64, err = dec.DecodeFloat64()
+	f.Valid = err == nil
+	return err
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode nil if this Bool is null.
+func (b Bool) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !b.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeBool(b.Bool)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// It supports boolean and nil input.
+func (b *Bool) DecodeMsgpack(dec *msgpack.Decoder) error {
+	null, err := msgpackNil(dec)
+	if err != nil || null {
+		b.Valid = false
+		return err
+	}
+	b.Bool, err = dec.DecodeBool()
+	b.Valid = err == nil
+	return err
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// It will encode nil if this Time is null, and the msgpack timestamp extension otherwise.
+func (t Time) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !t.Valid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeTime(t.Time)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// It supports timestamp and nil input.
+func (t *Time) DecodeMsgpack(dec *msgpack.Decoder) error {
+	null, err := msgpackNil(dec)
+	if err != nil || null {
+		t.Valid = false
+		return err
+	}
+	t.Time, err = dec.DecodeTime()
+	t.Valid = err == nil
+	return err
+}
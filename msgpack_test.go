@@ -0,0 +1,68 @@
+//go:build msgpack
+
+package null
+
+import (
+	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type msgpackRecord struct {
+	String String
+	Int    Int
+	Float  Float
+	Bool   Bool
+	Time   Time
+}
+
+func TestMsgpackRoundTrip(t *testing.T) {
+	in := msgpackRecord{
+		String: StringFrom("test"),
+		Int:    IntFrom(12345),
+		Float:  FloatFrom(1.2345),
+		Bool:   BoolFrom(true),
+		Time:   TimeFrom(timeValue),
+	}
+	data, err := msgpack.Marshal(in)
+	maybePanic(err)
+
+	var out msgpackRecord
+	err = msgpack.Unmarshal(data, &out)
+	maybePanic(err)
+	assertStr(t, out.String, "msgpack string")
+	assertInt(t, out.Int, "msgpack int")
+	assertFloat(t, out.Float, "msgpack float")
+	assertBool(t, out.Bool, "msgpack bool")
+	assertTime(t, out.Time, "msgpack time")
+}
+
+func TestMsgpackNull(t *testing.T) {
+	data, err := msgpack.Marshal(msgpackRecord{})
+	maybePanic(err)
+
+	// every field should be encoded as nil
+	var raw map[string]interface{}
+	err = msgpack.Unmarshal(data, &raw)
+	maybePanic(err)
+	for k, v := range raw {
+		if v != nil {
+			t.Errorf("bad msgpack null %s: %v ≠ nil\n", k, v)
+		}
+	}
+
+	out := msgpackRecord{
+		String: StringFrom("test"),
+		Int:    IntFrom(12345),
+		Float:  FloatFrom(1.2345),
+		Bool:   BoolFrom(true),
+		Time:   TimeFrom(timeValue),
+	}
+	err = msgpack.Unmarshal(data, &out)
+	maybePanic(err)
+	assertNullStr(t, out.String, "msgpack null string")
+	assertNullInt(t, out.Int, "msgpack null int")
+	assertNullFloat(t, out.Float, "msgpack null float")
+	assertNullBool(t, out.Bool, "msgpack null bool")
+	assertNullTime(t, out.Time, "msgpack null time")
+}
@@ -0,0 +1,62 @@
+package null
+
+import "bytes"
+
+// Nullable is implemented by pointers to every type in this package,
+// so generic code can check and clear values without knowing their type.
+//
+//	func clearIfNull(n null.Nullable) {
+//		if n.IsNull() {
+//			n.SetNull()
+//		}
+//	}
+//
+// Every type also has a Null method, the same as IsNull, for templates that
+// read better with it, as in {{if .Field.Null}}—{{else}}{{.Field}}{{end}}.
+// Optional is the exception: its embedded Null field takes the name.
+type Nullable interface {
+	IsNull() bool
+	SetNull()
+}
+
+// NullsLast controls where Compare places null values.
+// By default nulls sort before every valid value; if NullsLast is true they sort after.
+// Like NullZero, it applies to every type in the process.
+var NullsLast = false
+
+// compareNull orders two values by validity alone.
+// ok is false if both are valid, in which case the caller must compare their values.
+func compareNull(aValid, bValid bool) (c int, ok bool) {
+	switch {
+	case aValid && bValid:
+		return 0, false
+	case aValid == bValid:
+		return 0, true
+	case aValid != NullsLast:
+		return 1, true
+	}
+	return -1, true
+}
+
+// NullText is what MarshalText writes for null values, such as `\N` for MySQL's LOAD DATA
+// or "NULL" for other bulk-load formats. It defaults to empty.
+// UnmarshalText decodes both empty input and input matching NullText as null,
+// except for Bytes, where empty input is a valid, empty value.
+// Types that decode JSON strings or scanned text with UnmarshalText treat NullText as null there too.
+// Like NullZero, it applies to every type in the process.
+var NullText = []byte{}
+
+// nullText returns a copy of NullText, so callers can't modify it through MarshalText's result.
+func nullText() []byte {
+	return append([]byte{}, NullText...)
+}
+
+// isNullText reports whether text is empty or matches NullText.
+func isNullText(text []byte) bool {
+	return len(text) == 0 || matchesNullText(text)
+}
+
+// matchesNullText reports whether NullText is set and text matches it.
+func matchesNullText(text []byte) bool {
+	return len(NullText) > 0 && bytes.Equal(text, NullText)
+}
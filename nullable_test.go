@@ -0,0 +1,148 @@
+package null
+
+import (
+	"encoding"
+	"html/template"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var (
+	_ Nullable = (*String)(nil)
+	_ Nullable = (*Int)(nil)
+	_ Nullable = (*Float)(nil)
+	_ Nullable = (*Bool)(nil)
+	_ Nullable = (*Time)(nil)
+	_ Nullable = (*Int8)(nil)
+	_ Nullable = (*Int16)(nil)
+	_ Nullable = (*Int32)(nil)
+	_ Nullable = (*Int64)(nil)
+	_ Nullable = (*Uint)(nil)
+	_ Nullable = (*Uint8)(nil)
+	_ Nullable = (*Uint16)(nil)
+	_ Nullable = (*Uint32)(nil)
+	_ Nullable = (*Uint64)(nil)
+	_ Nullable = (*Byte)(nil)
+	_ Nullable = (*Bytes)(nil)
+	_ Nullable = (*Rune)(nil)
+	_ Nullable = (*Color)(nil)
+	_ Nullable = (*Date)(nil)
+	_ Nullable = (*Duration)(nil)
+	_ Nullable = (*BigInt)(nil)
+	_ Nullable = (*JSON)(nil)
+	_ Nullable = (*IP)(nil)
+	_ Nullable = (*URL)(nil)
+	_ Nullable = (*UUID)(nil)
+	_ Nullable = (*StringArray)(nil)
+	_ Nullable = (*Percent)(nil)
+)
+
+func TestNullable(t *testing.T) {
+	for _, v := range validValues() {
+		typ := reflect.TypeOf(v)
+		ptr := reflect.New(typ)
+		ptr.Elem().Set(reflect.ValueOf(v))
+		n := ptr.Interface().(Nullable)
+		if n.IsNull() {
+			t.Errorf("%s should not be null", typ.Name())
+		}
+		n.SetNull()
+		if !n.IsNull() {
+			t.Errorf("%s should be null after SetNull()", typ.Name())
+		}
+		if !ptr.Elem().IsZero() {
+			t.Errorf("SetNull() should reset %s to zero, got %#v", typ.Name(), ptr.Elem().Interface())
+		}
+	}
+}
+
+func TestNullText(t *testing.T) {
+	defer func(text []byte) { NullText = text }(NullText)
+	for _, sentinel := range []string{"", `\N`} {
+		NullText = []byte(sentinel)
+		for _, v := range validValues() {
+			typ := reflect.TypeOf(v)
+			m, ok := v.(encoding.TextMarshaler)
+			if !ok {
+				continue
+			}
+			null := reflect.New(typ)
+			text, err := null.Elem().Interface().(encoding.TextMarshaler).MarshalText()
+			maybePanic(err)
+			if string(text) != sentinel {
+				t.Errorf("null %s MarshalText with NullText %q = %q", typ.Name(), sentinel, text)
+			}
+
+			// blank text is a valid, empty Bytes
+			inputs := []string{"", sentinel}
+			if typ == reflect.TypeOf(Bytes{}) {
+				inputs = inputs[1:]
+				if sentinel == "" {
+					inputs = nil
+				}
+			}
+			for _, in := range inputs {
+				ptr := reflect.New(typ)
+				ptr.Elem().Set(reflect.ValueOf(v))
+				err := ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(in))
+				maybePanic(err)
+				if !ptr.Interface().(Nullable).IsNull() {
+					t.Errorf("%s UnmarshalText(%q) with NullText %q should be null", typ.Name(), in, sentinel)
+				}
+			}
+
+			text, err = m.MarshalText()
+			maybePanic(err)
+			ptr := reflect.New(typ)
+			ptr.Elem().Set(reflect.ValueOf(v))
+			err = ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText(text)
+			maybePanic(err)
+			if ptr.Interface().(Nullable).IsNull() {
+				t.Errorf("%s UnmarshalText(%q) with NullText %q should be valid", typ.Name(), text, sentinel)
+			}
+		}
+	}
+
+	var b Bytes
+	err := b.UnmarshalText([]byte{})
+	maybePanic(err)
+	if !b.Valid {
+		t.Error("blank Bytes text should stay a valid, empty Bytes with a custom NullText")
+	}
+
+	NullText = []byte(`\N`)
+	text, err := String{}.MarshalText()
+	maybePanic(err)
+	text[0] = 'x'
+	if string(NullText) != `\N` {
+		t.Errorf("modifying MarshalText's result changed NullText to %q", NullText)
+	}
+}
+
+func TestNullMethod(t *testing.T) {
+	for _, v := range validValues() {
+		for _, rv := range []reflect.Value{reflect.ValueOf(v), reflect.Zero(reflect.TypeOf(v))} {
+			null := rv.MethodByName("Null").Call(nil)[0].Bool()
+			if valid := rv.FieldByName("Valid").Bool(); null == valid {
+				t.Errorf("%s.Null() = %v with Valid %v", rv.Type().Name(), null, valid)
+			}
+		}
+	}
+
+	tmpl := template.Must(template.New("").Parse(`{{if .Name.Null}}—{{else}}{{.Name}}{{end}}`))
+	for _, tc := range []struct {
+		name String
+		want string
+	}{
+		{StringFrom("<b>"), "&lt;b&gt;"},
+		{String{}, "—"},
+	} {
+		var sb strings.Builder
+		err := tmpl.Execute(&sb, struct{ Name String }{tc.name})
+		maybePanic(err)
+		if sb.String() != tc.want {
+			t.Errorf("template output %q, want %q", sb.String(), tc.want)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// OmitNull returns the JSON encoding of v, leaving out the fields of v that hold a null value
+// from this package, such as a null String. encoding/json's omitempty tag never omits struct values,
+// so without this a null field always encodes as "field":null.
+// Valid zero values, such as an empty but valid String, are kept.
+//
+// Only the fields of v itself and of structs it embeds are checked; nested structs encode as usual.
+// If v is not a struct or a pointer to one, OmitNull returns what json.Marshal does.
+//
+// To strip null fields whenever a type is marshaled, call OmitNull from its MarshalJSON method,
+// converting to a type without that method first so that it doesn't call itself:
+//
+//	func (p Person) MarshalJSON() ([]byte, error) {
+//		type plain Person
+//		return null.OmitNull(plain(p))
+//	}
+func OmitNull(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return data, nil
+	}
+	omit := make(map[string]bool)
+	nullFields(rv, omit)
+	if len(omit) == 0 {
+		return data, nil
+	}
+	return omitKeys(data, omit)
+}
+
+// nullFields adds the JSON names of the null fields of the struct rv to omit.
+func nullFields(rv reflect.Value, omit map[string]bool) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := tag
+		if i := strings.IndexByte(tag, ','); i >= 0 {
+			name = tag[:i]
+		}
+		f := rv.Field(i)
+		if sf.Anonymous && name == "" {
+			if f.Kind() == reflect.Ptr {
+				if f.IsNil() {
+					continue
+				}
+				f = f.Elem()
+			}
+			if f.Kind() == reflect.Struct && !f.Type().Implements(isNullerType) {
+				nullFields(f, omit)
+				continue
+			}
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		if isNullField(f) {
+			omit[name] = true
+		}
+	}
+}
+
+// isNullField reports whether f holds a null value from this package, or a nil pointer to one.
+func isNullField(f reflect.Value) bool {
+	if !f.Type().Implements(isNullerType) {
+		return false
+	}
+	if f.Kind() == reflect.Ptr {
+		if f.IsNil() {
+			return true
+		}
+		f = f.Elem()
+	}
+	if !f.CanInterface() {
+		// fields promoted through an unexported embedded struct can't have their methods called,
+		// but every type in this package is null exactly when its Valid field is false
+		valid := f.FieldByName("Valid")
+		return valid.Kind() == reflect.Bool && !valid.Bool()
+	}
+	return f.Interface().(interface{ IsNull() bool }).IsNull()
+}
+
+var isNullerType = reflect.TypeOf((*interface{ IsNull() bool })(nil)).Elem()
+
+// omitKeys removes the members named in omit from the JSON object data, if their value is null.
+func omitKeys(data []byte, omit map[string]bool) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, len(data))
+	out = append(out, '{')
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := tok.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		if omit[key] && string(value) == "null" {
+			continue
+		}
+		if len(out) > 1 {
+			out = append(out, ',')
+		}
+		if out, err = appendJSONString(out, key); err != nil {
+			return nil, err
+		}
+		out = append(out, ':')
+		out = append(out, value...)
+	}
+	return append(out, '}'), nil
+}
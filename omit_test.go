@@ -0,0 +1,72 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type omitInner struct {
+	Note String `json:"note"`
+}
+
+type omitStruct struct {
+	Name    String  `json:"name"`
+	Age     Int     `json:"age,omitempty"`
+	Email   *String `json:"email"`
+	Nick    String
+	Skipped String    `json:"-"`
+	Plain   *string   `json:"plain"`
+	Inner   omitInner `json:"inner"`
+	omitInner
+}
+
+type omitPerson struct {
+	Name String `json:"name"`
+	Age  Int    `json:"age"`
+}
+
+func (p omitPerson) MarshalJSON() ([]byte, error) {
+	type plain omitPerson
+	return OmitNull(plain(p))
+}
+
+func TestOmitNull(t *testing.T) {
+	data, err := OmitNull(omitStruct{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"plain":null,"inner":{"note":null}}`, "all null")
+
+	email := StringFrom("a@example.com")
+	data, err = OmitNull(&omitStruct{
+		Name:      StringFrom(""),
+		Age:       IntFrom(0),
+		Email:     &email,
+		Nick:      StringFrom("nick"),
+		Skipped:   StringFrom("skipped"),
+		omitInner: omitInner{Note: StringFrom("note")},
+	})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"name":"","age":0,"email":"a@example.com","Nick":"nick","plain":null,"inner":{"note":null},"note":"note"}`, "valid values")
+
+	null := NewString("", false)
+	data, err = OmitNull(omitStruct{Email: &null})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"plain":null,"inner":{"note":null}}`, "pointer to null")
+
+	data, err = OmitNull(StringFrom("test"))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"test"`, "non-struct")
+
+	data, err = OmitNull(map[string]String{"a": {}})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"a":null}`, "map")
+}
+
+func TestOmitNullMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(omitPerson{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{}`, "null fields")
+
+	data, err = json.Marshal([]omitPerson{{Name: StringFrom("test")}, {Age: IntFrom(1)}})
+	maybePanic(err)
+	assertJSONEquals(t, data, `[{"name":"test"},{"age":1}]`, "nested")
+}
@@ -0,0 +1,69 @@
+//go:build go1.18
+
+package null
+
+// Optional is a Null that also records whether it was present in decoded JSON,
+// for PATCH-style updates where an omitted field and an explicit null mean different things.
+// A field omitted from a JSON object leaves Present false, explicit null sets Present
+// and leaves Valid false, and any other value sets both.
+// It marshals like Null, so absent and null values both encode as null.
+//
+// encoding/json calls UnmarshalJSON for every field in the input, including explicit nulls,
+// but leaves omitted fields untouched. Decode into a freshly zeroed value, or call Unset first,
+// so that Present reflects only the current input.
+type Optional[T any] struct {
+	Null[T]
+	Present bool
+}
+
+// NewOptional creates a new Optional that is present.
+func NewOptional[T any](v T, valid bool) Optional[T] {
+	return Optional[T]{
+		Null:    NewValue(v, valid),
+		Present: true,
+	}
+}
+
+// OptionalFrom creates a new Optional that is present and valid.
+func OptionalFrom[T any](v T) Optional[T] {
+	return NewOptional(v, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It marks this Optional present, then decodes data like Null does.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Present = true
+	return o.Null.UnmarshalJSON(data)
+}
+
+// SetValid changes this value and also sets it to be present and non-null.
+func (o *Optional[T]) SetValid(v T) {
+	o.Null.SetValid(v)
+	o.Present = true
+}
+
+// SetNull sets this value to an explicit null, resetting its value to zero.
+func (o *Optional[T]) SetNull() {
+	o.Null.SetNull()
+	o.Present = true
+}
+
+// Unset sets this value to absent and null, resetting its value to zero.
+func (o *Optional[T]) Unset() {
+	o.Null.SetNull()
+	o.Present = false
+}
+
+// IsPresent returns true if this value was present in the input, even as null.
+func (o Optional[T]) IsPresent() bool {
+	return o.Present
+}
+
+// Merge applies patch to this Optional with JSON merge patch semantics.
+// If patch is present, it replaces this value, so an explicit null clears it.
+// If patch is absent, this value is left unchanged.
+func (o *Optional[T]) Merge(patch Optional[T]) {
+	if patch.Present {
+		*o = patch
+	}
+}
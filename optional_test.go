@@ -0,0 +1,101 @@
+//go:build go1.18
+
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var _ Nullable = (*Optional[string])(nil)
+
+type optionalPatch struct {
+	Name Optional[string] `json:"name"`
+	Age  Optional[int]    `json:"age"`
+}
+
+func TestUnmarshalOptional(t *testing.T) {
+	var absent optionalPatch
+	err := json.Unmarshal([]byte(`{}`), &absent)
+	maybePanic(err)
+	assertOptional(t, absent.Name, false, false, "absent field")
+
+	var explicit optionalPatch
+	err = json.Unmarshal([]byte(`{"name":null}`), &explicit)
+	maybePanic(err)
+	assertOptional(t, explicit.Name, true, false, "explicit null")
+	assertOptional(t, explicit.Age, false, false, "field absent beside explicit null")
+
+	var present optionalPatch
+	err = json.Unmarshal([]byte(`{"name":"test","age":0}`), &present)
+	maybePanic(err)
+	assertOptional(t, present.Name, true, true, "present value")
+	if present.Name.Value != "test" {
+		t.Errorf("bad present value: %q ≠ %q", present.Name.Value, "test")
+	}
+	assertOptional(t, present.Age, true, true, "present zero value")
+
+	var badType optionalPatch
+	err = json.Unmarshal([]byte(`{"age":"x"}`), &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertOptional(t, badType.Age, true, false, "wrong type json")
+}
+
+func TestMarshalOptional(t *testing.T) {
+	data, err := json.Marshal(optionalPatch{Name: OptionalFrom("test"), Age: NewOptional(0, false)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"name":"test","age":null}`, "present json marshal")
+
+	data, err = json.Marshal(optionalPatch{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"name":null,"age":null}`, "absent json marshal")
+}
+
+func TestOptionalSetters(t *testing.T) {
+	var o Optional[string]
+	o.SetValid("test")
+	assertOptional(t, o, true, true, "SetValid()")
+
+	o.SetNull()
+	assertOptional(t, o, true, false, "SetNull()")
+
+	o = OptionalFrom("test")
+	o.Unset()
+	assertOptional(t, o, false, false, "Unset()")
+	if o.Value != "" {
+		t.Errorf("Unset() left %q", o.Value)
+	}
+}
+
+func assertOptional[T any](t *testing.T, o Optional[T], present, valid bool, from string) {
+	t.Helper()
+	if o.IsPresent() != present {
+		t.Errorf("%s: present = %v, want %v", from, o.IsPresent(), present)
+	}
+	if o.IsValid() != valid {
+		t.Errorf("%s: valid = %v, want %v", from, o.IsValid(), valid)
+	}
+}
+
+func TestOptionalMerge(t *testing.T) {
+	target := OptionalFrom("old")
+	target.Merge(Optional[string]{})
+	assertOptional(t, target, true, true, "merged absent patch")
+	if target.Value != "old" {
+		t.Errorf("absent patch should preserve: %q", target.Value)
+	}
+
+	var patch optionalPatch
+	err := json.Unmarshal([]byte(`{"name":null}`), &patch)
+	maybePanic(err)
+	target.Merge(patch.Name)
+	assertOptional(t, target, true, false, "merged explicit null")
+
+	target.Merge(OptionalFrom("new"))
+	assertOptional(t, target, true, true, "merged value")
+	if target.Value != "new" {
+		t.Errorf("present patch should overwrite: %q", target.Value)
+	}
+}
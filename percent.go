@@ -0,0 +1,176 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// Percent is a nullable fraction between 0 and 1 inclusive, for rates such as discounts.
+// It will marshal to null if null, and to a number otherwise.
+// Decoding and scanning reject values outside [0, 1].
+type Percent struct {
+	Percent float64
+	Valid   bool
+}
+
+// NewPercent creates a new Percent. It does not check that f is between 0 and 1.
+func NewPercent(f float64, valid bool) Percent {
+	return Percent{
+		Percent: f,
+		Valid:   valid,
+	}
+}
+
+// PercentFrom creates a new Percent that will be valid if f is between 0 and 1.
+// An error is returned otherwise.
+func PercentFrom(f float64) (Percent, error) {
+	var p Percent
+	err := p.SetValid(f)
+	return p, err
+}
+
+// PercentFromPtr creates a new Percent that will be null if f is nil.
+// An error is returned if *f is not between 0 and 1.
+func PercentFromPtr(f *float64) (Percent, error) {
+	if f == nil {
+		return NewPercent(0, false), nil
+	}
+	return PercentFrom(*f)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input, and returns an error for numbers outside [0, 1].
+func (p *Percent) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Percent")
+	switch data = bytes.TrimSpace(data); kindOf(data) {
+	case jsonNumber:
+		return p.UnmarshalText(data)
+	case jsonNull:
+		p.Valid = false
+		return nil
+	}
+	p.Valid = false
+	return jsonTypeError(data, "null.Percent")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Percent if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not a number between 0 and 1.
+func (p *Percent) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		p.Valid = false
+		return nil
+	}
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		p.Valid = false
+		return err
+	}
+	return p.SetValid(f)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Percent is null.
+func (p Percent) MarshalJSON() ([]byte, error) {
+	if !p.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatFloat(p.Percent, 'f', -1, 64)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Percent is null.
+func (p Percent) MarshalText() ([]byte, error) {
+	if !p.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatFloat(p.Percent, 'f', -1, 64)), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports float64 and int64 values, as well as numeric []byte and string values,
+// and returns an error for values outside [0, 1].
+func (p *Percent) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case float64:
+		return p.SetValid(x)
+	case int64:
+		return p.SetValid(float64(x))
+	case []byte:
+		return p.UnmarshalText(x)
+	case string:
+		return p.UnmarshalText([]byte(x))
+	case nil:
+		p.Valid = false
+		return nil
+	}
+	p.Valid = false
+	return fmt.Errorf("null: cannot scan type %T into null.Percent: %v", value, value)
+}
+
+// Value implements driver.Valuer.
+// It returns a float64, or nil if this Percent is null.
+func (p Percent) Value() (driver.Value, error) {
+	if !p.Valid {
+		return nil, nil
+	}
+	return p.Percent, nil
+}
+
+// SetValid changes this Percent's value and also sets it to be non-null.
+// It returns an error and sets this Percent to null if v is not between 0 and 1.
+func (p *Percent) SetValid(v float64) error {
+	if !(v >= 0 && v <= 1) {
+		p.Percent, p.Valid = 0, false
+		return fmt.Errorf("null: %v is out of range for null.Percent, which must be between 0 and 1", v)
+	}
+	p.Percent = v
+	p.Valid = true
+	return nil
+}
+
+// SetNull sets this Percent to null, resetting its value to zero.
+func (p *Percent) SetNull() {
+	p.Percent = 0
+	p.Valid = false
+}
+
+// Ptr returns a pointer to this Percent's value, or a nil pointer if this Percent is null.
+func (p Percent) Ptr() *float64 {
+	if !p.Valid {
+		return nil
+	}
+	return &p.Percent
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (p Percent) ValueOrZero() float64 {
+	if !p.Valid {
+		return 0
+	}
+	return p.Percent
+}
+
+// IsNull returns true if this Percent is null.
+func (p Percent) IsNull() bool {
+	return !p.Valid
+}
+
+// Null is the same as IsNull.
+func (p Percent) Null() bool {
+	return !p.Valid
+}
+
+// IsValid returns true if this Percent is not null.
+func (p Percent) IsValid() bool {
+	return p.Valid
+}
+
+// IsZero returns true for null Percents and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Percent from a valid zero one.
+func (p Percent) IsZero() bool {
+	return !p.Valid || p.Percent == 0
+}
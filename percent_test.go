@@ -0,0 +1,213 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+var (
+	percentJSON  = []byte(`0.25`)
+	percentValue = 0.25
+)
+
+func TestPercentFrom(t *testing.T) {
+	p, err := PercentFrom(percentValue)
+	maybePanic(err)
+	assertPercent(t, p, "PercentFrom()")
+
+	for _, f := range []float64{0, 1} {
+		bound, err := PercentFrom(f)
+		maybePanic(err)
+		if !bound.Valid || bound.Percent != f {
+			t.Errorf("bad PercentFrom(%v): %v", f, bound)
+		}
+	}
+
+	for _, f := range []float64{-0.01, 1.01, math.NaN(), math.Inf(1)} {
+		invalid, err := PercentFrom(f)
+		if err == nil {
+			t.Errorf("expected error for PercentFrom(%v)", f)
+		}
+		assertNullPercent(t, invalid, "PercentFrom() out of range")
+	}
+}
+
+func TestPercentFromPtr(t *testing.T) {
+	v := percentValue
+	p, err := PercentFromPtr(&v)
+	maybePanic(err)
+	assertPercent(t, p, "PercentFromPtr()")
+
+	null, err := PercentFromPtr(nil)
+	maybePanic(err)
+	assertNullPercent(t, null, "PercentFromPtr(nil)")
+}
+
+func TestUnmarshalPercent(t *testing.T) {
+	var p Percent
+	err := json.Unmarshal(percentJSON, &p)
+	maybePanic(err)
+	assertPercent(t, p, "percent json")
+
+	var one Percent
+	err = json.Unmarshal([]byte(`1`), &one)
+	maybePanic(err)
+	if !one.Valid || one.Percent != 1 {
+		t.Errorf("bad boundary percent json: %v", one)
+	}
+
+	var null Percent
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullPercent(t, null, "null json")
+
+	for _, bad := range []string{`1.5`, `-1`, `"0.5"`, `true`} {
+		invalid, err := PercentFrom(percentValue)
+		maybePanic(err)
+		if err := json.Unmarshal([]byte(bad), &invalid); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+		assertNullPercent(t, invalid, bad)
+	}
+}
+
+func TestTextUnmarshalPercent(t *testing.T) {
+	var p Percent
+	err := p.UnmarshalText([]byte("0.25"))
+	maybePanic(err)
+	assertPercent(t, p, "UnmarshalText() percent")
+
+	var blank Percent
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullPercent(t, blank, "UnmarshalText() empty percent")
+
+	var invalid Percent
+	if err := invalid.UnmarshalText([]byte("2")); err == nil {
+		t.Error("expected error")
+	}
+	assertNullPercent(t, invalid, "UnmarshalText() out of range")
+}
+
+func TestMarshalPercent(t *testing.T) {
+	p := NewPercent(percentValue, true)
+	data, err := json.Marshal(p)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0.25", "non-empty json marshal")
+
+	data, err = p.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0.25", "non-empty text marshal")
+
+	null := NewPercent(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestPercentScan(t *testing.T) {
+	var p Percent
+	err := p.Scan(percentValue)
+	maybePanic(err)
+	assertPercent(t, p, "scanned float64")
+
+	var s Percent
+	err = s.Scan([]byte("0.25"))
+	maybePanic(err)
+	assertPercent(t, s, "scanned []byte")
+
+	var one Percent
+	err = one.Scan(int64(1))
+	maybePanic(err)
+	if !one.Valid || one.Percent != 1 {
+		t.Errorf("bad scanned int64: %v", one)
+	}
+
+	var null Percent
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullPercent(t, null, "scanned null")
+
+	for _, bad := range []interface{}{1.5, int64(2), "-0.5", true} {
+		var invalid Percent
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("expected error scanning %v", bad)
+		}
+		assertNullPercent(t, invalid, "scanned bad value")
+	}
+
+	v, err := p.Value()
+	maybePanic(err)
+	if v != percentValue {
+		t.Errorf("bad percent value: %v ≠ %v\n", v, percentValue)
+	}
+}
+
+func TestPercentPointer(t *testing.T) {
+	p := NewPercent(percentValue, true)
+	ptr := p.Ptr()
+	if *ptr != percentValue {
+		t.Errorf("bad %s percent: %#v ≠ %v\n", "pointer", ptr, percentValue)
+	}
+
+	null := NewPercent(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s percent: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestPercentIsZero(t *testing.T) {
+	p := NewPercent(percentValue, true)
+	if p.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewPercent(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := NewPercent(0, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestPercentSetValid(t *testing.T) {
+	change := NewPercent(0, false)
+	assertNullPercent(t, change, "SetValid()")
+	maybePanic(change.SetValid(percentValue))
+	assertPercent(t, change, "SetValid()")
+
+	if err := change.SetValid(2); err == nil {
+		t.Error("expected error")
+	}
+	assertNullPercent(t, change, "SetValid() out of range")
+}
+
+func assertPercent(t *testing.T, p Percent, from string) {
+	if p.Percent != percentValue {
+		t.Errorf("bad %s percent: %v ≠ %v\n", from, p.Percent, percentValue)
+	}
+	if !p.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullPercent(t *testing.T, p Percent, from string) {
+	if p.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
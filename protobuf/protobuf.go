@@ -0,0 +1,93 @@
+// Package protobuf converts between null types and the protobuf well-known wrapper types,
+// for services that expose the same fields over JSON and gRPC.
+// A null value maps to a nil wrapper and a nil wrapper maps to a null value.
+package protobuf
+
+import (
+	"time"
+
+	"github.com/guregu/null"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// StringToProto converts s to a StringValue, or nil if s is null.
+func StringToProto(s null.String) *wrapperspb.StringValue {
+	if !s.Valid {
+		return nil
+	}
+	return wrapperspb.String(s.String)
+}
+
+// StringFromProto creates a String that will be null if v is nil.
+func StringFromProto(v *wrapperspb.StringValue) null.String {
+	if v == nil {
+		return null.NewString("", false)
+	}
+	return null.StringFrom(v.GetValue())
+}
+
+// IntToProto converts i to an Int64Value, or nil if i is null.
+func IntToProto(i null.Int) *wrapperspb.Int64Value {
+	if !i.Valid {
+		return nil
+	}
+	return wrapperspb.Int64(i.Int64)
+}
+
+// IntFromProto creates an Int that will be null if v is nil.
+func IntFromProto(v *wrapperspb.Int64Value) null.Int {
+	if v == nil {
+		return null.NewInt(0, false)
+	}
+	return null.IntFrom(v.GetValue())
+}
+
+// FloatToProto converts f to a DoubleValue, or nil if f is null.
+func FloatToProto(f null.Float) *wrapperspb.DoubleValue {
+	if !f.Valid {
+		return nil
+	}
+	return wrapperspb.Double(f.Float64)
+}
+
+// FloatFromProto creates a Float that will be null if v is nil.
+func FloatFromProto(v *wrapperspb.DoubleValue) null.Float {
+	if v == nil {
+		return null.NewFloat(0, false)
+	}
+	return null.FloatFrom(v.GetValue())
+}
+
+// BoolToProto converts b to a BoolValue, or nil if b is null.
+func BoolToProto(b null.Bool) *wrapperspb.BoolValue {
+	if !b.Valid {
+		return nil
+	}
+	return wrapperspb.Bool(b.Bool)
+}
+
+// BoolFromProto creates a Bool that will be null if v is nil.
+func BoolFromProto(v *wrapperspb.BoolValue) null.Bool {
+	if v == nil {
+		return null.NewBool(false, false)
+	}
+	return null.BoolFrom(v.GetValue())
+}
+
+// TimeToProto converts t to a Timestamp, or nil if t is null.
+func TimeToProto(t null.Time) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+// TimeFromProto creates a Time that will be null if v is nil.
+// The resulting time is in UTC.
+func TimeFromProto(v *timestamppb.Timestamp) null.Time {
+	if v == nil {
+		return null.NewTime(time.Time{}, false)
+	}
+	return null.TimeFrom(v.AsTime())
+}
@@ -0,0 +1,102 @@
+package protobuf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var timeValue = time.Date(2012, time.December, 21, 21, 21, 21, 0, time.UTC)
+
+func TestStringProto(t *testing.T) {
+	v := StringToProto(null.StringFrom("test"))
+	if v.GetValue() != "test" {
+		t.Errorf("bad StringValue: %v ≠ %s\n", v, "test")
+	}
+	if v := StringToProto(null.NewString("", false)); v != nil {
+		t.Errorf("bad null StringValue: %v ≠ nil\n", v)
+	}
+
+	s := StringFromProto(wrapperspb.String(""))
+	if !s.Valid || s.String != "" {
+		t.Errorf("bad StringFromProto(): %#v\n", s)
+	}
+	if s := StringFromProto(nil); s.Valid {
+		t.Error("StringFromProto(nil)", "is valid, but should be invalid")
+	}
+}
+
+func TestIntProto(t *testing.T) {
+	v := IntToProto(null.IntFrom(12345))
+	if v.GetValue() != 12345 {
+		t.Errorf("bad Int64Value: %v ≠ %d\n", v, 12345)
+	}
+	if v := IntToProto(null.NewInt(0, false)); v != nil {
+		t.Errorf("bad null Int64Value: %v ≠ nil\n", v)
+	}
+
+	i := IntFromProto(wrapperspb.Int64(0))
+	if !i.Valid || i.Int64 != 0 {
+		t.Errorf("bad IntFromProto(): %#v\n", i)
+	}
+	if i := IntFromProto(nil); i.Valid {
+		t.Error("IntFromProto(nil)", "is valid, but should be invalid")
+	}
+}
+
+func TestFloatProto(t *testing.T) {
+	v := FloatToProto(null.FloatFrom(1.2345))
+	if v.GetValue() != 1.2345 {
+		t.Errorf("bad DoubleValue: %v ≠ %v\n", v, 1.2345)
+	}
+	if v := FloatToProto(null.NewFloat(0, false)); v != nil {
+		t.Errorf("bad null DoubleValue: %v ≠ nil\n", v)
+	}
+
+	f := FloatFromProto(wrapperspb.Double(1.2345))
+	if !f.Valid || f.Float64 != 1.2345 {
+		t.Errorf("bad FloatFromProto(): %#v\n", f)
+	}
+	if f := FloatFromProto(nil); f.Valid {
+		t.Error("FloatFromProto(nil)", "is valid, but should be invalid")
+	}
+}
+
+func TestBoolProto(t *testing.T) {
+	v := BoolToProto(null.BoolFrom(true))
+	if !v.GetValue() {
+		t.Errorf("bad BoolValue: %v ≠ %v\n", v, true)
+	}
+	if v := BoolToProto(null.NewBool(false, false)); v != nil {
+		t.Errorf("bad null BoolValue: %v ≠ nil\n", v)
+	}
+
+	b := BoolFromProto(wrapperspb.Bool(false))
+	if !b.Valid || b.Bool {
+		t.Errorf("bad BoolFromProto(): %#v\n", b)
+	}
+	if b := BoolFromProto(nil); b.Valid {
+		t.Error("BoolFromProto(nil)", "is valid, but should be invalid")
+	}
+}
+
+func TestTimeProto(t *testing.T) {
+	v := TimeToProto(null.TimeFrom(timeValue.In(time.FixedZone("test", -5*60*60))))
+	if !v.AsTime().Equal(timeValue) {
+		t.Errorf("bad Timestamp: %v ≠ %v\n", v.AsTime(), timeValue)
+	}
+	if v := TimeToProto(null.NewTime(time.Time{}, false)); v != nil {
+		t.Errorf("bad null Timestamp: %v ≠ nil\n", v)
+	}
+
+	ti := TimeFromProto(timestamppb.New(timeValue))
+	if !ti.Valid || ti.Time != timeValue {
+		t.Errorf("bad TimeFromProto(): %v ≠ %v\n", ti.Time, timeValue)
+	}
+	if ti := TimeFromProto(nil); ti.Valid {
+		t.Error("TimeFromProto(nil)", "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,171 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+)
+
+// Rune is a nullable rune, for single-character columns that may hold
+// multi-byte characters such as currency symbols.
+// It will marshal to null if null, and to a one-character string otherwise.
+// Blank string input will be considered null.
+type Rune struct {
+	Rune  rune
+	Valid bool
+}
+
+// NewRune creates a new Rune
+func NewRune(r rune, valid bool) Rune {
+	return Rune{
+		Rune:  r,
+		Valid: valid,
+	}
+}
+
+// RuneFrom creates a new Rune that will always be valid.
+func RuneFrom(r rune) Rune {
+	return NewRune(r, true)
+}
+
+// RuneFromPtr creates a new Rune that will be null if r is nil.
+func RuneFromPtr(r *rune) Rune {
+	if r == nil {
+		return NewRune(0, false)
+	}
+	return NewRune(*r, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports one-character string and null input. Blank string input produces a null Rune.
+// It will return an error if the string is longer than one character.
+func (r *Rune) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Rune")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		return r.UnmarshalText([]byte(x))
+	case nil:
+		r.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Rune", reflect.TypeOf(v).Name())
+	}
+	r.Valid = false
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Rune if the input is blank or matches NullText.
+// It will return an error if the input is not exactly one UTF-8 encoded character.
+func (r *Rune) UnmarshalText(text []byte) error {
+	if isNullText(text) {
+		r.Valid = false
+		return nil
+	}
+	// DecodeRune returns RuneError with size 1 for invalid UTF-8;
+	// an encoded U+FFFD has size 3 and is a valid character
+	c, size := utf8.DecodeRune(text)
+	if size != len(text) || (c == utf8.RuneError && size == 1) {
+		r.Valid = false
+		return fmt.Errorf("null: cannot use %q as a null.Rune: must be a single character", text)
+	}
+	r.Rune, r.Valid = c, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Rune is null.
+func (r Rune) MarshalJSON() ([]byte, error) {
+	if !r.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(string(r.Rune))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Rune is null.
+func (r Rune) MarshalText() ([]byte, error) {
+	if !r.Valid {
+		return nullText(), nil
+	}
+	return []byte(string(r.Rune)), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports one-character []byte and string values.
+// Blank []byte and string values produce a null Rune.
+func (r *Rune) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case []byte:
+		return r.UnmarshalText(x)
+	case string:
+		return r.UnmarshalText([]byte(x))
+	case nil:
+		r.Valid = false
+		return nil
+	}
+	r.Valid = false
+	return fmt.Errorf("null: cannot scan type %T into null.Rune: %v", value, value)
+}
+
+// Value implements driver.Valuer.
+// It returns a one-character string, or nil if this Rune is null.
+func (r Rune) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return string(r.Rune), nil
+}
+
+// SetValid changes this Rune's value and also sets it to be non-null.
+func (r *Rune) SetValid(v rune) {
+	r.Rune = v
+	r.Valid = true
+}
+
+// SetNull sets this Rune to null, resetting its value to zero.
+func (r *Rune) SetNull() {
+	r.Rune = 0
+	r.Valid = false
+}
+
+// Ptr returns a pointer to this Rune's value, or a nil pointer if this Rune is null.
+func (r Rune) Ptr() *rune {
+	if !r.Valid {
+		return nil
+	}
+	return &r.Rune
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (r Rune) ValueOrZero() rune {
+	if !r.Valid {
+		return 0
+	}
+	return r.Rune
+}
+
+// IsNull returns true if this Rune is null.
+func (r Rune) IsNull() bool {
+	return !r.Valid
+}
+
+// Null is the same as IsNull.
+func (r Rune) Null() bool {
+	return !r.Valid
+}
+
+// IsValid returns true if this Rune is not null.
+func (r Rune) IsValid() bool {
+	return r.Valid
+}
+
+// IsZero returns true for null Runes and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Rune from a valid zero one.
+func (r Rune) IsZero() bool {
+	return !r.Valid || r.Rune == 0
+}
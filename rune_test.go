@@ -0,0 +1,229 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"unicode/utf8"
+)
+
+var (
+	runeJSON      = []byte(`"€"`)
+	runeValue     = '€'
+	asciiRuneJSON = []byte(`"A"`)
+)
+
+func TestRuneFrom(t *testing.T) {
+	r := RuneFrom(runeValue)
+	assertRune(t, r, "RuneFrom()")
+
+	zero := RuneFrom(0)
+	if !zero.Valid {
+		t.Error("RuneFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestRuneFromPtr(t *testing.T) {
+	v := runeValue
+	r := RuneFromPtr(&v)
+	assertRune(t, r, "RuneFromPtr()")
+
+	null := RuneFromPtr(nil)
+	assertNullRune(t, null, "RuneFromPtr(nil)")
+}
+
+func TestUnmarshalRune(t *testing.T) {
+	var r Rune
+	err := json.Unmarshal(runeJSON, &r)
+	maybePanic(err)
+	assertRune(t, r, "multi-byte rune json")
+
+	var ascii Rune
+	err = json.Unmarshal(asciiRuneJSON, &ascii)
+	maybePanic(err)
+	if !ascii.Valid || ascii.Rune != 'A' {
+		t.Errorf("bad %s rune: %q (valid: %t)\n", "ascii rune json", ascii.Rune, ascii.Valid)
+	}
+
+	var escaped Rune
+	err = json.Unmarshal([]byte(`"\u20ac"`), &escaped)
+	maybePanic(err)
+	assertRune(t, escaped, "escaped rune json")
+
+	var blank Rune
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullRune(t, blank, "blank string json")
+
+	var null Rune
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullRune(t, null, "null json")
+
+	var long Rune
+	err = json.Unmarshal([]byte(`"€$"`), &long)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullRune(t, long, "two rune json")
+
+	var badType Rune
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullRune(t, badType, "wrong type json")
+}
+
+func TestTextUnmarshalRune(t *testing.T) {
+	var r Rune
+	err := r.UnmarshalText([]byte("€"))
+	maybePanic(err)
+	assertRune(t, r, "UnmarshalText() rune")
+
+	var blank Rune
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullRune(t, blank, "UnmarshalText() empty rune")
+
+	var invalid Rune
+	err = invalid.UnmarshalText([]byte{0xe2, 0x82})
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullRune(t, invalid, "UnmarshalText() invalid UTF-8")
+
+	var single Rune
+	err = single.UnmarshalText([]byte{0xff})
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullRune(t, single, "UnmarshalText() invalid byte")
+
+	var replacement Rune
+	err = replacement.UnmarshalText([]byte("\xef\xbf\xbd"))
+	maybePanic(err)
+	if !replacement.Valid || replacement.Rune != utf8.RuneError {
+		t.Errorf("UnmarshalText() of an encoded U+FFFD = %q (valid: %t), want U+FFFD", replacement.Rune, replacement.Valid)
+	}
+}
+
+func TestMarshalRune(t *testing.T) {
+	r := RuneFrom(runeValue)
+	data, err := json.Marshal(r)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(runeJSON), "non-empty json marshal")
+
+	data, err = r.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "€", "non-empty text marshal")
+
+	null := RuneFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestRunePointer(t *testing.T) {
+	r := RuneFrom(runeValue)
+	ptr := r.Ptr()
+	if *ptr != runeValue {
+		t.Errorf("bad %s rune: %#v ≠ %v\n", "pointer", ptr, runeValue)
+	}
+
+	null := NewRune(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s rune: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestRuneValueOrZero(t *testing.T) {
+	valid := RuneFrom(runeValue)
+	if valid.ValueOrZero() != runeValue {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewRune(runeValue, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestRuneIsZero(t *testing.T) {
+	r := RuneFrom(runeValue)
+	if r.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := RuneFromPtr(nil)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := RuneFrom(0)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestRuneSetValid(t *testing.T) {
+	change := NewRune(0, false)
+	assertNullRune(t, change, "SetValid()")
+	change.SetValid(runeValue)
+	assertRune(t, change, "SetValid()")
+}
+
+func TestRuneScan(t *testing.T) {
+	var b Rune
+	err := b.Scan([]byte("€"))
+	maybePanic(err)
+	assertRune(t, b, "scanned []byte")
+
+	var s Rune
+	err = s.Scan("€")
+	maybePanic(err)
+	assertRune(t, s, "scanned string")
+
+	var null Rune
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullRune(t, null, "scanned null")
+
+	var long Rune
+	err = long.Scan("ab")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullRune(t, long, "scanned long string")
+
+	v, err := s.Value()
+	maybePanic(err)
+	if v != "€" {
+		t.Errorf("bad rune value: %v ≠ %v\n", v, "€")
+	}
+}
+
+func assertRune(t *testing.T, r Rune, from string) {
+	if r.Rune != runeValue {
+		t.Errorf("bad %s rune: %q ≠ %q\n", from, r.Rune, runeValue)
+	}
+	if !r.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullRune(t *testing.T, r Rune, from string) {
+	if r.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
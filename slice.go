@@ -0,0 +1,131 @@
+//go:build go1.18
+
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Slice is a nullable slice, for JSON array columns where null and [] are different.
+// It supports SQL and JSON serialization.
+// It will marshal to null if null, and to a JSON array otherwise.
+// A nil slice is null, but an empty non-nil slice is valid: SliceFrom[T](nil) is null
+// while SliceFrom([]T{}) is valid and empty.
+// In SQL it is stored as JSON text.
+type Slice[T any] struct {
+	Slice []T
+	Valid bool
+}
+
+// NewSlice creates a new Slice.
+func NewSlice[T any](s []T, valid bool) Slice[T] {
+	return Slice[T]{
+		Slice: s,
+		Valid: valid,
+	}
+}
+
+// SliceFrom creates a new Slice that will be null if s is nil.
+func SliceFrom[T any](s []T) Slice[T] {
+	return NewSlice(s, s != nil)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports null input, and otherwise any array encoding/json can decode into []T.
+func (s *Slice[T]) UnmarshalJSON(data []byte) error {
+	if kindOf(bytes.TrimSpace(data)) == jsonNull {
+		s.Slice, s.Valid = nil, false
+		return nil
+	}
+	var v []T
+	if err := json.Unmarshal(data, &v); err != nil {
+		s.Valid = false
+		return unmarshalError(data, fmt.Sprintf("%T", *s), err)
+	}
+	if v == nil {
+		v = []T{}
+	}
+	s.Slice, s.Valid = v, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Slice is null.
+func (s Slice[T]) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+	if s.Slice == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.Slice)
+}
+
+// Scan implements sql.Scanner.
+// It decodes JSON text given as []byte or string.
+func (s *Slice[T]) Scan(value interface{}) error {
+	var v []T
+	valid, err := scanJSON(&v, value)
+	if err != nil {
+		s.Valid = false
+		return fmt.Errorf("null: cannot scan into null.Slice: %w", err)
+	}
+	if valid && v == nil {
+		v = []T{}
+	}
+	s.Slice, s.Valid = v, valid
+	return nil
+}
+
+// Value implements driver.Valuer.
+// It returns this Slice encoded as JSON, or nil if this Slice is null.
+func (s Slice[T]) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.MarshalJSON()
+}
+
+// SetValid changes this Slice's value and also sets it to be non-null.
+func (s *Slice[T]) SetValid(v []T) {
+	s.Slice = v
+	s.Valid = true
+}
+
+// SetNull sets this Slice to null, resetting its value to zero.
+func (s *Slice[T]) SetNull() {
+	s.Slice = nil
+	s.Valid = false
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (s Slice[T]) ValueOrZero() []T {
+	if !s.Valid {
+		return nil
+	}
+	return s.Slice
+}
+
+// IsNull returns true if this Slice is null.
+func (s Slice[T]) IsNull() bool {
+	return !s.Valid
+}
+
+// Null is the same as IsNull.
+func (s Slice[T]) Null() bool {
+	return !s.Valid
+}
+
+// IsValid returns true if this Slice is not null.
+func (s Slice[T]) IsValid() bool {
+	return s.Valid
+}
+
+// IsZero returns true for null Slices and for valid ones holding an empty slice, for omitempty support.
+// Use IsValid to tell a null Slice from a valid zero one.
+func (s Slice[T]) IsZero() bool {
+	return !s.Valid || len(s.Slice) == 0
+}
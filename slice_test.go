@@ -0,0 +1,146 @@
+//go:build go1.18
+
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var _ Nullable = (*Slice[string])(nil)
+
+var sliceJSON = []byte(`["a","b"]`)
+
+func TestSliceFrom(t *testing.T) {
+	s := SliceFrom([]string{"a", "b"})
+	assertSlice(t, s, "SliceFrom()")
+
+	null := SliceFrom[string](nil)
+	assertNullSlice(t, null, "SliceFrom(nil)")
+
+	empty := SliceFrom([]string{})
+	if !empty.Valid || len(empty.Slice) != 0 {
+		t.Errorf("SliceFrom([]) should be valid and empty, got %v (valid: %t)", empty.Slice, empty.Valid)
+	}
+}
+
+func TestUnmarshalSlice(t *testing.T) {
+	var s Slice[string]
+	err := json.Unmarshal(sliceJSON, &s)
+	maybePanic(err)
+	assertSlice(t, s, "array json")
+
+	var empty Slice[string]
+	err = json.Unmarshal([]byte(`[]`), &empty)
+	maybePanic(err)
+	if !empty.Valid || empty.Slice == nil || len(empty.Slice) != 0 {
+		t.Errorf("[] should be a valid empty slice, got %v (valid: %t)", empty.Slice, empty.Valid)
+	}
+
+	var null Slice[string]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullSlice(t, null, "null json")
+
+	var badType Slice[string]
+	err = json.Unmarshal([]byte(`{"a":1}`), &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullSlice(t, badType, "object json")
+}
+
+func TestMarshalSlice(t *testing.T) {
+	data, err := json.Marshal(SliceFrom([]string{"a", "b"}))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(sliceJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(SliceFrom([]string{}))
+	maybePanic(err)
+	assertJSONEquals(t, data, "[]", "empty json marshal")
+
+	data, err = json.Marshal(NewSlice[string](nil, true))
+	maybePanic(err)
+	assertJSONEquals(t, data, "[]", "valid nil json marshal")
+
+	data, err = json.Marshal(SliceFrom[string](nil))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestSliceScan(t *testing.T) {
+	var b Slice[string]
+	err := b.Scan(sliceJSON)
+	maybePanic(err)
+	assertSlice(t, b, "scanned []byte")
+
+	var s Slice[string]
+	err = s.Scan(string(sliceJSON))
+	maybePanic(err)
+	assertSlice(t, s, "scanned string")
+
+	var empty Slice[string]
+	err = empty.Scan([]byte("[]"))
+	maybePanic(err)
+	if !empty.Valid || len(empty.Slice) != 0 {
+		t.Errorf("scanned [] should be a valid empty slice, got %v (valid: %t)", empty.Slice, empty.Valid)
+	}
+
+	var null Slice[string]
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullSlice(t, null, "scanned null")
+
+	var wrong Slice[string]
+	err = wrong.Scan(int64(1))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullSlice(t, wrong, "scanned int64")
+
+	v, err := b.Value()
+	maybePanic(err)
+	assertJSONEquals(t, v.([]byte), string(sliceJSON), "slice value")
+
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null slice value: %v ≠ nil\n", v)
+	}
+}
+
+func TestSliceValueOrZero(t *testing.T) {
+	valid := SliceFrom([]string{"a", "b"})
+	if len(valid.ValueOrZero()) != 2 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+	invalid := NewSlice([]string{"a"}, false)
+	if invalid.ValueOrZero() != nil {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+	if !invalid.IsZero() || valid.IsZero() || !SliceFrom([]string{}).IsZero() {
+		t.Error("unexpected IsZero")
+	}
+	if invalid.IsValid() || !SliceFrom([]string{}).IsValid() {
+		t.Error("unexpected IsValid")
+	}
+
+	var change Slice[string]
+	change.SetValid([]string{"a", "b"})
+	assertSlice(t, change, "SetValid()")
+}
+
+func assertSlice(t *testing.T, s Slice[string], from string) {
+	if len(s.Slice) != 2 || s.Slice[0] != "a" || s.Slice[1] != "b" {
+		t.Errorf("bad %s slice: %v\n", from, s.Slice)
+	}
+	if !s.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullSlice(t *testing.T, s Slice[string], from string) {
+	if s.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
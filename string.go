@@ -8,8 +8,39 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 )
 
+// NullDisplay is what the fmt package prints for null values,
+// through the String and Format methods of each type.
+var NullDisplay = "null"
+
+// NullZero makes the JSON encoding of null values their zero value instead of null:
+// "" for String, 0 for Int and Float, false for Bool, and the zero instant for Time.
+// This suits consumers that reject null, such as strict schema validators,
+// at the cost of the null/zero distinction this package exists for:
+// the output can no longer tell a null value from a valid zero one.
+// Like the zero subpackage, a blank String or zero Time decodes back to null,
+// but 0 and false decode to valid values.
+// It applies to every value in the process, so set it once during initialization.
+var NullZero = false
+
+// TrimStringBlank controls which strings count as blank, and so decode to a null String.
+// By default only the empty string is blank: UnmarshalJSON and UnmarshalText decode "" as null,
+// a string of spaces is a valid value, and Scan keeps every string valid, as sql.NullString does.
+// If TrimStringBlank is true, any string that is empty after strings.TrimSpace is blank,
+// and UnmarshalJSON, UnmarshalText and Scan all decode it as null.
+// Like NullZero, it applies to every String in the process.
+var TrimStringBlank = false
+
+// isBlankString reports whether s decodes to a null String, following TrimStringBlank.
+func isBlankString(s string) bool {
+	if TrimStringBlank {
+		return strings.TrimSpace(s) == ""
+	}
+	return s == ""
+}
+
 // String is a nullable string. It supports SQL and JSON serialization.
 // It will marshal to null if null. Blank string input will be considered null.
 type String struct {
@@ -29,6 +60,12 @@ func StringFromPtr(s *string) String {
 	return NewString(*s, true)
 }
 
+// StringFromSQL creates a new String with the same value and validity as s.
+// The embedded NullString field converts back the other way.
+func StringFromSQL(s sql.NullString) String {
+	return String{NullString: s}
+}
+
 // NewString creates a new String
 func NewString(s string, valid bool) String {
 	return String{
@@ -40,41 +77,135 @@ func NewString(s string, valid bool) String {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string and null input. Blank string input produces a null String.
-// It also supports unmarshalling a sql.NullString.
-func (s *String) UnmarshalJSON(data []byte) error {
-	var err error
+// It supports string and null input. Blank string input produces a null String;
+// see TrimStringBlank for whether whitespace-only input is blank.
+// It also supports unmarshalling a sql.NullString, which is null unless its Valid field is true.
+func (s *String) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.String")
 	var v interface{}
 	json.Unmarshal(data, &v)
 	switch x := v.(type) {
 	case string:
 		s.String = x
 	case map[string]interface{}:
+		s.NullString = sql.NullString{}
 		err = json.Unmarshal(data, &s.NullString)
+		s.Valid = err == nil && s.Valid && !isBlankString(s.String)
+		return err
 	case nil:
 		s.Valid = false
 		return nil
 	default:
 		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.String", reflect.TypeOf(v).Name())
 	}
-	s.Valid = (err == nil && s.String != "")
+	s.Valid = (err == nil && !isBlankString(s.String))
 	return err
 }
 
+// UnmarshalJSONStrict is like UnmarshalJSON, but returns an error for input that
+// would produce a null String, such as null or a blank string.
+// Call it from a custom UnmarshalJSON to reject missing values for required fields.
+func (s *String) UnmarshalJSONStrict(data []byte) error {
+	if err := s.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	if !s.Valid {
+		return strictNullError(data, "null.String")
+	}
+	return nil
+}
+
+// UnmarshalJSONValidate is like UnmarshalJSON, but also calls validate with the decoded value.
+// If validate returns an error, this String is set to null and the error is returned wrapped.
+// Input that produces a null String is not validated.
+func (s *String) UnmarshalJSONValidate(data []byte, validate func(string) error) error {
+	if err := s.UnmarshalJSON(data); err != nil || !s.Valid {
+		return err
+	}
+	if err := validate(s.String); err != nil {
+		s.Valid = false
+		return validateError(data, "null.String", err)
+	}
+	return nil
+}
+
 // MarshalJSON implements json.Marshaler.
-// It will encode null if this String is null.
+// It will encode null if this String is null, or its zero value if NullZero is set.
 func (s String) MarshalJSON() ([]byte, error) {
-	if s.IsZero() {
+	if !s.Valid {
+		if NullZero {
+			return []byte(`""`), nil
+		}
 		return []byte("null"), nil
 	}
-	return json.Marshal(s.String)
+	return s.AppendJSON(make([]byte, 0, len(s.String)+2))
+}
+
+// AppendJSON appends the JSON encoding of this String to dst, as MarshalJSON would encode it.
+// Encoders can reuse dst across calls to avoid allocating for every value.
+func (s String) AppendJSON(dst []byte) ([]byte, error) {
+	if !s.Valid {
+		if NullZero {
+			return append(dst, `""`...), nil
+		}
+		return append(dst, "null"...), nil
+	}
+	return appendJSONString(dst, s.String)
+}
+
+// appendJSONString appends s to dst as a JSON string, exactly as encoding/json would encode it.
+// It quotes plain ASCII directly, and falls back to json.Marshal for anything that needs escaping.
+func appendJSONString(dst []byte, s string) ([]byte, error) {
+	for i := 0; i < len(s); i++ {
+		if jsonEscapes(s[i]) {
+			b, err := json.Marshal(s)
+			if err != nil {
+				return dst, err
+			}
+			return append(dst, b...), nil
+		}
+	}
+	dst = append(dst, '"')
+	dst = append(dst, s...)
+	return append(dst, '"'), nil
+}
+
+// jsonEscapes reports whether encoding/json escapes c, or might escape it as part of
+// a multi-byte sequence, when encoding a string.
+func jsonEscapes(c byte) bool {
+	return c < 0x20 || c >= 0x80 || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&'
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this String is null.
+func (s String) MarshalText() ([]byte, error) {
+	if !s.Valid {
+		return nullText(), nil
+	}
+	return []byte(s.String), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null String if the input is a blank string.
+// It will unmarshal to a null String if the input is blank, as TrimStringBlank defines, or matches NullText.
 func (s *String) UnmarshalText(text []byte) error {
-	s.String = string(text)
-	s.Valid = s.String != ""
+	if isNullText(text) || isBlankString(string(text)) {
+		s.String, s.Valid = "", false
+		return nil
+	}
+	s.String, s.Valid = string(text), true
+	return nil
+}
+
+// Scan implements sql.Scanner. It accepts the values sql.NullString does.
+// Values of named types, such as `type MyString string`, are scanned as their underlying type.
+// Blank strings are valid unless TrimStringBlank is set.
+func (s *String) Scan(value interface{}) error {
+	if err := s.NullString.Scan(driverValue(value)); err != nil {
+		return err
+	}
+	if TrimStringBlank && s.Valid && isBlankString(s.String) {
+		s.String, s.Valid = "", false
+	}
 	return nil
 }
 
@@ -84,6 +215,30 @@ func (s *String) SetValid(v string) {
 	s.Valid = true
 }
 
+// Set changes this String's value and validity at once.
+// Unlike SetValid, it can keep a value while marking this String null.
+func (s *String) Set(v string, valid bool) {
+	s.String = v
+	s.Valid = valid
+}
+
+// SetNull sets this String to null, resetting its value to zero
+// so that stale data doesn't show through the String field.
+func (s *String) SetNull() {
+	s.String = ""
+	s.Valid = false
+}
+
+// SetValidIf sets this String to v if cond is true, and to null otherwise.
+// Unlike Set, it resets the value to zero when cond is false.
+func (s *String) SetValidIf(v string, cond bool) {
+	if !cond {
+		s.SetNull()
+		return
+	}
+	s.SetValid(v)
+}
+
 // Ptr returns a pointer to this String's value, or a nil pointer if this String is null.
 func (s String) Ptr() *string {
 	if !s.Valid {
@@ -92,8 +247,120 @@ func (s String) Ptr() *string {
 	return &s.String
 }
 
-// IsZero returns true for null or empty strings, for future omitempty support. (Go 1.4?)
-// Will return false s if blank but non-null.
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (s String) ValueOrZero() string {
+	if !s.Valid {
+		return ""
+	}
+	return s.String
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (s String) ValueOr(def string) string {
+	if !s.Valid {
+		return def
+	}
+	return s.String
+}
+
+// Equal returns true if both strings have the same value or are both null.
+func (s String) Equal(other String) bool {
+	return s.Valid == other.Valid && (!s.Valid || s.String == other.String)
+}
+
+// Compare returns -1 if this String sorts before other, 1 if it sorts after, and 0 if they are equal,
+// for use with sort and slices.SortFunc. Valid values are ordered by value.
+// Null values are equal to each other and sort before every valid value, or after if NullsLast is set.
+func (s String) Compare(other String) int {
+	if c, ok := compareNull(s.Valid, other.Valid); ok {
+		return c
+	}
+	return strings.Compare(s.String, other.String)
+}
+
+// Changed returns true if this String differs from old: if one is null and the other isn't,
+// or if both are valid with different values. It is the inverse of Equal.
+func (s String) Changed(old String) bool {
+	return !s.Equal(old)
+}
+
+// Or returns this String if it is valid, otherwise other.
+func (s String) Or(other String) String {
+	if s.Valid {
+		return s
+	}
+	return other
+}
+
+// Merge copies patch into this String if patch is valid, and otherwise leaves it unchanged,
+// for applying partial updates where a null field means "keep the current value".
+func (s *String) Merge(patch String) {
+	if patch.Valid {
+		*s = patch
+	}
+}
+
+// Map returns a valid String holding f applied to this String's value,
+// or this String unchanged if it is null. f is not called for a null String.
+func (s String) Map(f func(string) string) String {
+	if !s.Valid {
+		return s
+	}
+	return StringFrom(f(s.String))
+}
+
+// ToInt converts this String to an Int by parsing it as a base 10 integer, like IntFromString.
+// A null or blank String converts to a null Int, and an error is returned if it is not an integer.
+func (s String) ToInt() (Int, error) {
+	if !s.Valid {
+		return NewInt(0, false), nil
+	}
+	return IntFromString(s.String)
+}
+
+// Coalesce returns the first valid String in vals, or a null String if none are valid.
+// Like SQL's COALESCE, it can be used to pick a value from several optional sources.
+func Coalesce(vals ...String) String {
+	for _, v := range vals {
+		if v.Valid {
+			return v
+		}
+	}
+	return NewString("", false)
+}
+
+// Format implements fmt.Formatter, printing this String's value, or NullDisplay if null.
+// String cannot implement fmt.Stringer, as a String method would hide the String field.
+// The %#v verb keeps printing the Go syntax of the whole struct, as it would without this method.
+func (s String) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "null.String{NullString:%#v}", s.NullString)
+		return
+	}
+	if !s.Valid {
+		fmt.Fprint(f, NullDisplay)
+		return
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), s.String)
+}
+
+// IsNull returns true if this String is null.
+func (s String) IsNull() bool {
+	return !s.Valid
+}
+
+// Null is the same as IsNull.
+func (s String) Null() bool {
+	return !s.Valid
+}
+
+// IsValid returns true if this String is not null.
+func (s String) IsValid() bool {
+	return s.Valid
+}
+
+// IsZero returns true for null Strings and for valid ones holding a blank string, for omitempty support.
+// Use IsValid to tell a null String from a valid zero one.
 func (s String) IsZero() bool {
 	return !s.Valid || s.String == ""
 }
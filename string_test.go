@@ -1,8 +1,21 @@
 package null
 
 import (
+	"bytes"
+	"database/sql"
+	"encoding"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 var (
@@ -36,6 +49,20 @@ func TestStringFromPtr(t *testing.T) {
 	assertNullStr(t, null, "StringFromPtr(nil)")
 }
 
+func TestStringFromSQL(t *testing.T) {
+	v := StringFromSQL(sql.NullString{String: "test", Valid: true})
+	assertStr(t, v, "StringFromSQL()")
+	if v.NullString != (sql.NullString{String: "test", Valid: true}) {
+		t.Errorf("bad NullString round trip: %v", v.NullString)
+	}
+
+	null := StringFromSQL(sql.NullString{})
+	assertNullStr(t, null, "StringFromSQL() null")
+	if null.NullString != (sql.NullString{}) {
+		t.Errorf("bad null NullString round trip: %v", null.NullString)
+	}
+}
+
 func TestUnmarshalString(t *testing.T) {
 	var str String
 	err := json.Unmarshal(stringJSON, &str)
@@ -47,6 +74,11 @@ func TestUnmarshalString(t *testing.T) {
 	maybePanic(err)
 	assertStr(t, ns, "sql.NullString json")
 
+	invalidObj := StringFrom("test")
+	err = json.Unmarshal([]byte(`{"String":"test","Valid":false}`), &invalidObj)
+	maybePanic(err)
+	assertNullStr(t, invalidObj, "sql.NullString json with Valid false")
+
 	var blank String
 	err = json.Unmarshal(blankStringJSON, &blank)
 	maybePanic(err)
@@ -65,6 +97,49 @@ func TestUnmarshalString(t *testing.T) {
 	assertNullStr(t, badType, "wrong type json")
 }
 
+func TestStringUnmarshalJSONStrict(t *testing.T) {
+	var v String
+	err := v.UnmarshalJSONStrict(stringJSON)
+	maybePanic(err)
+	assertStr(t, v, "strict json")
+
+	var null String
+	if err := null.UnmarshalJSONStrict(nullJSON); err == nil {
+		t.Error("expected error")
+	}
+	assertNullStr(t, null, "strict null json")
+
+	var blank String
+	if err := blank.UnmarshalJSONStrict(blankStringJSON); err == nil {
+		t.Error("expected error")
+	}
+	assertNullStr(t, blank, "strict blank string json")
+}
+
+func TestStringUnmarshalJSONValidate(t *testing.T) {
+	validate := func(v string) error {
+		if len(v) > 4 {
+			return errTooBig
+		}
+		return nil
+	}
+
+	var v String
+	err := v.UnmarshalJSONValidate(stringJSON, validate)
+	assertValidated(t, err, nil, "validated json")
+	assertStr(t, v, "validated json")
+
+	var invalid String
+	err = invalid.UnmarshalJSONValidate([]byte(`"too long"`), validate)
+	assertValidated(t, err, errTooBig, "invalid json")
+	assertNullStr(t, invalid, "invalid json")
+
+	var null String
+	err = null.UnmarshalJSONValidate(nullJSON, func(string) error { return errTooBig })
+	assertValidated(t, err, nil, "null json")
+	assertNullStr(t, null, "null json")
+}
+
 func TestTextUnmarshalString(t *testing.T) {
 	var str String
 	err := str.UnmarshalText([]byte("test"))
@@ -124,19 +199,182 @@ func TestStringIsZero(t *testing.T) {
 	}
 
 	blank := StringFrom("")
-	if blank.IsZero() {
-		t.Errorf("IsZero() should be false")
+	if !blank.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !blank.IsValid() {
+		t.Errorf("IsValid() should be true")
 	}
 
 	empty := NewString("", true)
-	if empty.IsZero() {
-		t.Errorf("IsZero() should be false")
+	if !empty.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !empty.IsValid() {
+		t.Errorf("IsValid() should be true")
 	}
 
 	null := StringFromPtr(nil)
 	if !null.IsZero() {
 		t.Errorf("IsZero() should be true")
 	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+}
+
+func TestStringValueOrZero(t *testing.T) {
+	valid := StringFrom("test")
+	if valid.ValueOrZero() != "test" {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewString("test", false)
+	if invalid.ValueOrZero() != "" {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestStringCompare(t *testing.T) {
+	for _, tc := range []struct {
+		a, b       String
+		want, last int
+	}{
+		{StringFrom("a"), StringFrom("b"), -1, -1},
+		{StringFrom("b"), StringFrom("a"), 1, 1},
+		{StringFrom("a"), StringFrom("a"), 0, 0},
+		{NewString("", false), StringFrom(""), -1, 1},
+		{StringFrom("a"), NewString("", false), 1, -1},
+	} {
+		if got := tc.a.Compare(tc.b); got != tc.want {
+			t.Errorf("Compare(%v, %v) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+		NullsLast = true
+		if got := tc.a.Compare(tc.b); got != tc.last {
+			t.Errorf("Compare(%v, %v) with NullsLast = %d, want %d", tc.a, tc.b, got, tc.last)
+		}
+		NullsLast = false
+	}
+
+	strs := []String{StringFrom("b"), NewString("", false), StringFrom("a"), StringFrom("")}
+	sort.Slice(strs, func(i, j int) bool { return strs[i].Compare(strs[j]) < 0 })
+	want := []String{NewString("", false), StringFrom(""), StringFrom("a"), StringFrom("b")}
+	if !reflect.DeepEqual(strs, want) {
+		t.Errorf("bad sort: %v ≠ %v", strs, want)
+	}
+}
+
+func TestStringEqual(t *testing.T) {
+	str1 := NewString("foo", false)
+	str2 := NewString("foo", false)
+	assertStringEqualIsTrue(t, str1, str2)
+
+	str1 = NewString("foo", false)
+	str2 = NewString("bar", false)
+	assertStringEqualIsTrue(t, str1, str2)
+
+	str1 = NewString("foo", true)
+	str2 = NewString("foo", true)
+	assertStringEqualIsTrue(t, str1, str2)
+
+	str1 = NewString("foo", true)
+	str2 = NewString("foo", false)
+	assertStringEqualIsFalse(t, str1, str2)
+
+	str1 = NewString("foo", true)
+	str2 = NewString("bar", true)
+	assertStringEqualIsFalse(t, str1, str2)
+}
+
+func TestStringValueOr(t *testing.T) {
+	if v := StringFrom("test").ValueOr("active"); v != "test" {
+		t.Error("unexpected ValueOr", v)
+	}
+	if v := StringFrom("").ValueOr("active"); v != "" {
+		t.Error("unexpected ValueOr for valid blank String", v)
+	}
+	if v := NewString("test", false).ValueOr("active"); v != "active" {
+		t.Error("unexpected ValueOr", v)
+	}
+}
+
+func TestStringOr(t *testing.T) {
+	valid := StringFrom("test")
+	null := NewString("other", false)
+	assertStr(t, valid.Or(StringFrom("other")), "valid.Or()")
+	assertStr(t, null.Or(valid), "null.Or(valid)")
+	assertNullStr(t, null.Or(NewString("", false)), "null.Or(null)")
+
+	// chaining
+	assertStr(t, null.Or(null).Or(valid).Or(StringFrom("other")), "chained Or()")
+}
+
+func TestStringToInt(t *testing.T) {
+	i, err := StringFrom("12345").ToInt()
+	maybePanic(err)
+	assertInt(t, i, "ToInt()")
+
+	for _, s := range []String{NewString("12345", false), StringFrom("")} {
+		null, err := s.ToInt()
+		maybePanic(err)
+		assertNullInt(t, null, "null or blank ToInt()")
+	}
+
+	bad, err := StringFrom("12a").ToInt()
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt(t, bad, "malformed ToInt()")
+}
+
+func TestStringMap(t *testing.T) {
+	assertStr(t, StringFrom("  test  ").Map(strings.TrimSpace), "Map()")
+
+	called := false
+	null := NewString("  test  ", false).Map(func(v string) string {
+		called = true
+		return v
+	})
+	assertNullStr(t, null, "null Map()")
+	if called {
+		t.Error("Map() should not call f for a null String")
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	null := NewString("other", false)
+	assertStr(t, Coalesce(null, StringFrom("test"), StringFrom("other")), "Coalesce() first valid")
+	assertStr(t, Coalesce(StringFrom("test")), "Coalesce() single")
+
+	// a valid blank string is still valid
+	blank := Coalesce(null, StringFrom(""), StringFrom("test"))
+	if !blank.Valid || blank.String != "" {
+		t.Errorf("Coalesce() should return the valid blank string, got %#v", blank)
+	}
+
+	assertNullStr(t, Coalesce(null, null), "Coalesce() all null")
+	assertNullStr(t, Coalesce(), "Coalesce() no args")
+}
+
+func TestStringFormat(t *testing.T) {
+	str := StringFrom("test")
+	if out := fmt.Sprintf("%s %v %q", str, str, str); out != `test test "test"` {
+		t.Errorf("bad fmt output: %s\n", out)
+	}
+
+	null := NewString("test", false)
+	if out := fmt.Sprintf("%s %v", null, null); out != "null null" {
+		t.Errorf("bad null fmt output: %s\n", out)
+	}
+
+	want := `null.String{NullString:sql.NullString{String:"test", Valid:true}}`
+	if out := fmt.Sprintf("%#v", str); out != want {
+		t.Errorf("bad %%#v output: %s ≠ %s\n", out, want)
+	}
+	want = `null.String{NullString:sql.NullString{String:"test", Valid:false}}`
+	if out := fmt.Sprintf("%#v", null); out != want {
+		t.Errorf("bad null %%#v output: %s ≠ %s\n", out, want)
+	}
 }
 
 func TestStringSetValid(t *testing.T) {
@@ -146,6 +384,85 @@ func TestStringSetValid(t *testing.T) {
 	assertStr(t, change, "SetValid()")
 }
 
+func TestStringSet(t *testing.T) {
+	var s String
+	s.Set("test", true)
+	assertStr(t, s, "Set(x, true)")
+
+	s.Set("test", false)
+	assertNullStr(t, s, "Set(x, false)")
+	if s.String != "test" {
+		t.Errorf("Set(x, false) should keep the value, got %v", s.String)
+	}
+}
+
+func TestStringSetNull(t *testing.T) {
+	s := StringFrom("test")
+	s.SetNull()
+	assertNullStr(t, s, "SetNull()")
+	if s.String != "" {
+		t.Errorf("SetNull() should reset the value, got %v", s.String)
+	}
+}
+
+func TestStringSetValidIf(t *testing.T) {
+	var s String
+	s.SetValidIf("test", true)
+	assertStr(t, s, "SetValidIf(x, true)")
+
+	s.SetValidIf("test", false)
+	assertNullStr(t, s, "SetValidIf(x, false)")
+	if s.String != "" {
+		t.Errorf("SetValidIf(x, false) should reset the value, got %v", s.String)
+	}
+}
+
+func TestTrimStringBlank(t *testing.T) {
+	defer func(trim bool) { TrimStringBlank = trim }(TrimStringBlank)
+	for _, tc := range []struct {
+		in    string
+		trim  bool
+		valid bool
+	}{
+		{"", false, false},
+		{"   ", false, true},
+		{"x", false, true},
+		{"", true, false},
+		{"   ", true, false},
+		{" \t\n", true, false},
+		{"x", true, true},
+		{" x ", true, true},
+	} {
+		TrimStringBlank = tc.trim
+
+		var fromJSON String
+		err := json.Unmarshal([]byte(strconv.Quote(tc.in)), &fromJSON)
+		maybePanic(err)
+		var fromObject String
+		err = json.Unmarshal([]byte(`{"String":`+strconv.Quote(tc.in)+`,"Valid":true}`), &fromObject)
+		maybePanic(err)
+		var fromText String
+		err = fromText.UnmarshalText([]byte(tc.in))
+		maybePanic(err)
+		for _, s := range []String{fromJSON, fromObject, fromText} {
+			if s.Valid != tc.valid || (s.Valid && s.String != tc.in) {
+				t.Errorf("decoding %q with TrimStringBlank %v = %#v, want valid: %v", tc.in, tc.trim, s, tc.valid)
+			}
+		}
+
+		var scanned String
+		err = scanned.Scan(tc.in)
+		maybePanic(err)
+		// without TrimStringBlank, Scan keeps even empty strings, as sql.NullString does
+		if want := tc.valid || !tc.trim; scanned.Valid != want {
+			t.Errorf("Scan(%q) with TrimStringBlank %v = %#v, want valid: %v", tc.in, tc.trim, scanned, want)
+		}
+		if !scanned.Valid && scanned.String != "" {
+			t.Errorf("null scanned String kept %q", scanned.String)
+		}
+	}
+}
+
 func TestStringScan(t *testing.T) {
 	var str String
 	err := str.Scan("test")
@@ -158,6 +475,67 @@ func TestStringScan(t *testing.T) {
 	assertNullStr(t, null, "scanned null")
 }
 
+type namedString string
+
+func TestStringScanNamed(t *testing.T) {
+	var str String
+	err := str.Scan(namedString("test"))
+	maybePanic(err)
+	assertStr(t, str, "scanned named string")
+
+	type namedBytes []byte
+	var b String
+	err = b.Scan(namedBytes("test"))
+	maybePanic(err)
+	assertStr(t, b, "scanned named []byte")
+}
+
+func TestTextRow(t *testing.T) {
+	type row struct {
+		Name  String
+		Count Int
+		Score Float
+		Admin Bool
+		Seen  Time
+	}
+	fields := func(r *row) []interface{} {
+		return []interface{}{&r.Name, &r.Count, &r.Score, &r.Admin, &r.Seen}
+	}
+	rows := []row{
+		{StringFrom("test"), IntFrom(12345), FloatFrom(1.2345), BoolFrom(false), TimeFrom(timeValue)},
+		{NewString("", false), NewInt(0, false), NewFloat(0, false), NewBool(false, false), NewTime(time.Time{}, false)},
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for i := range rows {
+		var record []string
+		for _, f := range fields(&rows[i]) {
+			text, err := f.(encoding.TextMarshaler).MarshalText()
+			maybePanic(err)
+			record = append(record, string(text))
+		}
+		maybePanic(w.Write(record))
+	}
+	w.Flush()
+	want := "test,12345,1.2345,false," + timeString + "\n,,,,\n"
+	if buf.String() != want {
+		t.Errorf("bad csv: %q ≠ %q\n", buf.String(), want)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	maybePanic(err)
+	for i, record := range records {
+		var got row
+		for j, f := range fields(&got) {
+			maybePanic(f.(encoding.TextUnmarshaler).UnmarshalText([]byte(record[j])))
+		}
+		if got != rows[i] {
+			t.Errorf("bad csv row %d: %v ≠ %v\n", i, got, rows[i])
+		}
+	}
+}
+
 func maybePanic(err error) {
 	if err != nil {
 		panic(err)
@@ -179,8 +557,284 @@ func assertNullStr(t *testing.T, s String, from string) {
 	}
 }
 
+var errTooBig = errors.New("too big")
+
+func assertValidated(t *testing.T, err, want error, from string) {
+	t.Helper()
+	if !errors.Is(err, want) {
+		t.Errorf("bad %s validation error: %v ≠ %v\n", from, err, want)
+	}
+}
+
 func assertJSONEquals(t *testing.T, data []byte, cmp string, from string) {
 	if string(data) != cmp {
 		t.Errorf("bad %s data: %s ≠ %s\n", from, data, cmp)
 	}
 }
+
+func assertPanics(t *testing.T, f func(), from string) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Error(from, "should have panicked")
+		}
+	}()
+	f()
+}
+
+func assertStringEqualIsTrue(t *testing.T, a, b String) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of String{%q, Valid:%t} and String{%q, Valid:%t} should return true", a.String, a.Valid, b.String, b.Valid)
+	}
+}
+
+func assertStringEqualIsFalse(t *testing.T, a, b String) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of String{%q, Valid:%t} and String{%q, Valid:%t} should return false", a.String, a.Valid, b.String, b.Valid)
+	}
+}
+
+func TestChanged(t *testing.T) {
+	type values struct {
+		null, a, a2, b interface{}
+	}
+	// a and a2 are equal values held separately, and b differs from both
+	types := map[string]values{
+		"String": {String{}, StringFrom("a"), StringFrom("a"), StringFrom("b")},
+		"Int":    {Int{}, IntFrom(1), IntFrom(1), IntFrom(2)},
+		"Float":  {Float{}, FloatFrom(1.5), FloatFrom(1.5), FloatFrom(2.5)},
+		"Bool":   {Bool{}, BoolFrom(false), BoolFrom(false), BoolFrom(true)},
+		"Time":   {Time{}, TimeFrom(timeValue), TimeFrom(timeValue.In(time.FixedZone("test", 3600))), TimeFrom(timeValue.Add(time.Second))},
+		"BigInt": {BigInt{}, BigIntFrom(big.NewInt(1)), BigIntFrom(big.NewInt(1)), BigIntFrom(big.NewInt(2))},
+		"Bytes":  {Bytes{}, BytesFrom([]byte("a")), BytesFrom([]byte("a")), BytesFrom([]byte("b"))},
+	}
+	for name, v := range types {
+		changed := func(newer, old interface{}) bool {
+			out := reflect.ValueOf(newer).MethodByName("Changed").Call([]reflect.Value{reflect.ValueOf(old)})
+			return out[0].Bool()
+		}
+		if changed(v.null, v.null) {
+			t.Errorf("%s: null → null should not be a change", name)
+		}
+		if !changed(v.a, v.null) {
+			t.Errorf("%s: null → value should be a change", name)
+		}
+		if changed(v.a2, v.a) {
+			t.Errorf("%s: value → same value should not be a change", name)
+		}
+		if !changed(v.b, v.a) {
+			t.Errorf("%s: value → different value should be a change", name)
+		}
+		if !changed(v.null, v.a) {
+			t.Errorf("%s: value → null should be a change", name)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	s := StringFrom("old")
+	s.Merge(StringFrom("new"))
+	if s != StringFrom("new") {
+		t.Errorf("valid patch should overwrite: %v", s)
+	}
+	s.Merge(NewString("stale", false))
+	if s != StringFrom("new") {
+		t.Errorf("null patch should preserve: %v", s)
+	}
+
+	i := IntFrom(1)
+	i.Merge(IntFrom(0))
+	i.Merge(Int{})
+	if i != IntFrom(0) {
+		t.Errorf("bad Int merge: %v", i)
+	}
+
+	f := FloatFrom(1.5)
+	f.Merge(FloatFrom(2.5))
+	f.Merge(Float{})
+	if f != FloatFrom(2.5) {
+		t.Errorf("bad Float merge: %v", f)
+	}
+
+	b := BoolFrom(true)
+	b.Merge(BoolFrom(false))
+	b.Merge(Bool{})
+	if b != BoolFrom(false) {
+		t.Errorf("bad Bool merge: %v", b)
+	}
+
+	ti := TimeFrom(timeValue)
+	later := TimeFrom(timeValue.Add(time.Hour))
+	ti.Merge(later)
+	ti.Merge(Time{})
+	if !ti.Equal(later) {
+		t.Errorf("bad Time merge: %v", ti)
+	}
+
+	var null String
+	null.Merge(StringFrom("set"))
+	if null != StringFrom("set") {
+		t.Errorf("valid patch should overwrite a null value: %v", null)
+	}
+}
+
+// jsonAppender is implemented by types with an AppendJSON method.
+type jsonAppender interface {
+	json.Marshaler
+	AppendJSON(dst []byte) ([]byte, error)
+}
+
+func TestMarshalJSONNeverEmpty(t *testing.T) {
+	values := []json.Marshaler{
+		JSON{Valid: true},
+		JSONFrom([]byte{}),
+		NewJSON([]byte(" \n"), true),
+		BigInt{Valid: true},
+		IP{Valid: true},
+		URL{Valid: true},
+		Bytes{Valid: true},
+		StringArray{Valid: true},
+		FloatFrom(math.NaN()),
+		FloatFrom(math.Inf(1)),
+	}
+	for _, v := range validValues() {
+		values = append(values, v.(json.Marshaler), reflect.Zero(reflect.TypeOf(v)).Interface().(json.Marshaler))
+	}
+	defer func(nz bool) { NullZero = nz }(NullZero)
+	for _, NullZero = range []bool{false, true} {
+		for _, v := range values {
+			out, err := v.MarshalJSON()
+			if err != nil {
+				continue
+			}
+			if len(out) == 0 || !json.Valid(out) {
+				t.Errorf("%T.MarshalJSON() of %#v = %q, want valid JSON or an error (NullZero: %v)", v, v, out, NullZero)
+			}
+		}
+	}
+
+	data, err := json.Marshal(struct{ J JSON }{JSON{Valid: true}})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"J":null}`, "empty valid JSON in a struct")
+}
+
+func TestAppendJSON(t *testing.T) {
+	values := []jsonAppender{
+		StringFrom(`<"quoted"> & \ escaped`),
+		StringFrom("unicode €   \xff"),
+		StringFrom(""),
+		IntFrom(-12345),
+		FloatFrom(-1.5e-7),
+		NewBool(false, true),
+		TimeFrom(PositiveInfinity),
+		UnixTimeFrom(timeValue),
+		String{}, Int{}, Float{}, Bool{}, Time{}, UnixTime{},
+	}
+	for _, v := range validValues() {
+		if a, ok := v.(jsonAppender); ok {
+			values = append(values, a)
+		}
+	}
+	defer func(nz bool) { NullZero = nz }(NullZero)
+	for _, NullZero = range []bool{false, true} {
+		for _, v := range values {
+			want, err := v.MarshalJSON()
+			maybePanic(err)
+			prefix := []byte("prefix,")
+			got, err := v.AppendJSON(prefix)
+			maybePanic(err)
+			if string(got) != "prefix,"+string(want) {
+				t.Errorf("%T.AppendJSON() = %s, want prefix,%s (NullZero: %v)", v, got, want, NullZero)
+			}
+		}
+	}
+
+	nan := FloatFrom(math.NaN())
+	dst := []byte("prefix")
+	got, err := nan.AppendJSON(dst)
+	if err == nil {
+		t.Error("expected error for NaN")
+	}
+	if string(got) != "prefix" {
+		t.Errorf("AppendJSON() should leave dst unchanged on error, got %s", got)
+	}
+}
+
+// BenchmarkAppendJSON compares encoding a record field by field with MarshalJSON,
+// which allocates for every field, to appending each field to one reused buffer.
+func BenchmarkAppendJSON(b *testing.B) {
+	record := []jsonAppender{
+		StringFrom("test"),
+		IntFrom(12345),
+		FloatFrom(1.2345),
+		BoolFrom(true),
+		TimeFrom(timeValue),
+	}
+	b.Run("MarshalJSON", func(b *testing.B) {
+		b.ReportAllocs()
+		var buf []byte
+		for i := 0; i < b.N; i++ {
+			buf = buf[:0]
+			for _, v := range record {
+				data, err := v.MarshalJSON()
+				if err != nil {
+					b.Fatal(err)
+				}
+				buf = append(buf, data...)
+			}
+		}
+	})
+	b.Run("AppendJSON", func(b *testing.B) {
+		b.ReportAllocs()
+		var buf []byte
+		for i := 0; i < b.N; i++ {
+			buf = buf[:0]
+			for _, v := range record {
+				var err error
+				if buf, err = v.AppendJSON(buf); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func TestNullZero(t *testing.T) {
+	defer func(old bool) { NullZero = old }(NullZero)
+
+	type record struct {
+		S String
+		I Int
+		F Float
+		B Bool
+		T Time
+	}
+	null := record{}
+	valid := record{StringFrom("test"), IntFrom(12345), FloatFrom(1.2345), BoolFrom(true), TimeFrom(timeValue)}
+	validJSON := `{"S":"test","I":12345,"F":1.2345,"B":true,"T":"2012-12-21T21:21:21Z"}`
+
+	NullZero = false
+	data, err := json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"S":null,"I":null,"F":null,"B":null,"T":null}`, "null values")
+	data, err = json.Marshal(valid)
+	maybePanic(err)
+	assertJSONEquals(t, data, validJSON, "valid values")
+
+	NullZero = true
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"S":"","I":0,"F":0,"B":false,"T":"0001-01-01T00:00:00Z"}`, "null values with NullZero")
+	data, err = json.Marshal(valid)
+	maybePanic(err)
+	assertJSONEquals(t, data, validJSON, "valid values with NullZero")
+
+	// blank strings and the zero instant still decode to null
+	var out record
+	err = json.Unmarshal([]byte(`{"S":"","I":0,"F":0,"B":false,"T":"0001-01-01T00:00:00Z"}`), &out)
+	maybePanic(err)
+	assertNullStr(t, out.S, "NullZero string round trip")
+	assertNullTime(t, out.T, "NullZero time round trip")
+}
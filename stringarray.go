@@ -0,0 +1,254 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// StringArray is a nullable Postgres text[] array. It supports SQL and JSON serialization.
+// Its elements are Strings, so NULL elements survive a round trip.
+// It will marshal to null if null, and to a JSON array of strings and nulls otherwise.
+// A NULL column is null, but the empty array {} is valid and empty.
+type StringArray struct {
+	StringArray []String
+	Valid       bool
+}
+
+// NewStringArray creates a new StringArray
+func NewStringArray(a []String, valid bool) StringArray {
+	return StringArray{
+		StringArray: a,
+		Valid:       valid,
+	}
+}
+
+// StringArrayFrom creates a new StringArray with every element of a valid.
+// It will be null if a is nil.
+func StringArrayFrom(a []string) StringArray {
+	if a == nil {
+		return NewStringArray(nil, false)
+	}
+	elems := make([]String, len(a))
+	for i, s := range a {
+		elems[i] = StringFrom(s)
+	}
+	return NewStringArray(elems, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports null input and arrays of strings and nulls.
+// Unlike String, blank string elements are kept as valid blank strings.
+func (a *StringArray) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.StringArray")
+	data = bytes.TrimSpace(data)
+	if kindOf(data) == jsonNull {
+		a.StringArray, a.Valid = nil, false
+		return nil
+	}
+	if len(data) == 0 || data[0] != '[' {
+		a.Valid = false
+		return jsonTypeError(data, "null.StringArray")
+	}
+	var v []*string
+	if err := json.Unmarshal(data, &v); err != nil {
+		a.Valid = false
+		return err
+	}
+	elems := make([]String, len(v))
+	for i, s := range v {
+		if s != nil {
+			elems[i] = StringFrom(*s)
+		}
+	}
+	a.StringArray, a.Valid = elems, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this StringArray is null, and null for each null element.
+func (a StringArray) MarshalJSON() ([]byte, error) {
+	if !a.Valid {
+		return []byte("null"), nil
+	}
+	v := make([]*string, len(a.StringArray))
+	for i, s := range a.StringArray {
+		v[i] = s.Ptr()
+	}
+	return json.Marshal(v)
+}
+
+// Scan implements sql.Scanner.
+// It parses Postgres array literals such as {a,"b c",NULL} given as []byte or string.
+// Unquoted NULL elements are null, while "NULL" in quotes is the string NULL.
+// Multidimensional arrays are not supported.
+func (a *StringArray) Scan(value interface{}) error {
+	var err error
+	switch x := value.(type) {
+	case []byte:
+		a.StringArray, err = parseStringArray(string(x))
+	case string:
+		a.StringArray, err = parseStringArray(x)
+	case nil:
+		a.StringArray, a.Valid = nil, false
+		return nil
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.StringArray: %v", value, value)
+	}
+	a.Valid = err == nil
+	return err
+}
+
+// Value implements driver.Valuer.
+// It returns a Postgres array literal with every element quoted, or nil if this StringArray is null.
+func (a StringArray) Value() (driver.Value, error) {
+	if !a.Valid {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, s := range a.StringArray {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if !s.Valid {
+			b.WriteString("NULL")
+			continue
+		}
+		b.WriteByte('"')
+		for _, c := range []byte(s.String) {
+			if c == '"' || c == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(c)
+		}
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// SetValid changes this StringArray's value and also sets it to be non-null.
+func (a *StringArray) SetValid(v []String) {
+	a.StringArray = v
+	a.Valid = true
+}
+
+// SetNull sets this StringArray to null, resetting its value to zero.
+func (a *StringArray) SetNull() {
+	a.StringArray = nil
+	a.Valid = false
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (a StringArray) ValueOrZero() []String {
+	if !a.Valid {
+		return nil
+	}
+	return a.StringArray
+}
+
+// IsNull returns true if this StringArray is null.
+func (a StringArray) IsNull() bool {
+	return !a.Valid
+}
+
+// Null is the same as IsNull.
+func (a StringArray) Null() bool {
+	return !a.Valid
+}
+
+// IsValid returns true if this StringArray is not null.
+func (a StringArray) IsValid() bool {
+	return a.Valid
+}
+
+// IsZero returns true for null StringArrays and for valid ones with no elements, for omitempty support.
+// Use IsValid to tell a null StringArray from a valid empty one.
+func (a StringArray) IsZero() bool {
+	return !a.Valid || len(a.StringArray) == 0
+}
+
+// parseStringArray parses a one-dimensional Postgres array literal.
+// Elements are separated by commas and may be double-quoted, with backslash escaping
+// the next character. Whitespace around unquoted elements is ignored.
+func parseStringArray(s string) ([]String, error) {
+	errSyntax := func(reason string) error {
+		return fmt.Errorf("null: cannot parse %q as a null.StringArray: %s", s, reason)
+	}
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, errSyntax("must be enclosed in braces")
+	}
+	body := s[1 : len(s)-1]
+	elems := []String{}
+	if strings.TrimSpace(body) == "" {
+		return elems, nil
+	}
+	for i := 0; ; i++ {
+		for i < len(body) && isArraySpace(body[i]) {
+			i++
+		}
+		var elem []byte
+		if i < len(body) && body[i] == '"' {
+			for i++; i < len(body) && body[i] != '"'; i++ {
+				if body[i] == '\\' && i+1 < len(body) {
+					i++
+				}
+				elem = append(elem, body[i])
+			}
+			if i == len(body) {
+				return nil, errSyntax("unterminated quoted element")
+			}
+			i++
+			for i < len(body) && isArraySpace(body[i]) {
+				i++
+			}
+			elems = append(elems, StringFrom(string(elem)))
+		} else {
+			// keep is the length of elem without trailing unescaped whitespace
+			var keep int
+			var escaped bool
+			for ; i < len(body) && body[i] != ','; i++ {
+				switch c := body[i]; {
+				case c == '{' || c == '}':
+					return nil, errSyntax("multidimensional arrays are not supported")
+				case c == '"':
+					return nil, errSyntax("unexpected quote")
+				case c == '\\':
+					if i++; i == len(body) {
+						return nil, errSyntax("trailing backslash")
+					}
+					elem = append(elem, body[i])
+					keep, escaped = len(elem), true
+				case isArraySpace(c):
+					elem = append(elem, c)
+				default:
+					elem = append(elem, c)
+					keep = len(elem)
+				}
+			}
+			elem = elem[:keep]
+			switch {
+			case len(elem) == 0:
+				return nil, errSyntax("empty element")
+			case !escaped && strings.EqualFold(string(elem), "NULL"):
+				elems = append(elems, NewString("", false))
+			default:
+				elems = append(elems, StringFrom(string(elem)))
+			}
+		}
+		if i == len(body) {
+			return elems, nil
+		}
+		if body[i] != ',' {
+			return nil, errSyntax("expected a comma between elements")
+		}
+	}
+}
+
+// isArraySpace reports whether c is whitespace that Postgres ignores between array elements.
+func isArraySpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
@@ -0,0 +1,187 @@
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+var (
+	stringArrayLiteral = `{test,"a,b","say \"hi\"",NULL,"NULL",""}`
+	stringArrayJSON    = []byte(`["test","a,b","say \"hi\"",null,"NULL",""]`)
+	stringArrayValue   = []String{
+		StringFrom("test"),
+		StringFrom("a,b"),
+		StringFrom(`say "hi"`),
+		NewString("", false),
+		StringFrom("NULL"),
+		StringFrom(""),
+	}
+)
+
+func TestStringArrayFrom(t *testing.T) {
+	a := StringArrayFrom([]string{"test", ""})
+	want := []String{StringFrom("test"), StringFrom("")}
+	if !a.Valid || !reflect.DeepEqual(a.StringArray, want) {
+		t.Errorf("bad StringArrayFrom(): %#v ≠ %#v\n", a.StringArray, want)
+	}
+
+	empty := StringArrayFrom([]string{})
+	if !empty.Valid || len(empty.StringArray) != 0 {
+		t.Error("StringArrayFrom([]string{})", "should be valid and empty")
+	}
+
+	null := StringArrayFrom(nil)
+	assertNullStringArray(t, null, "StringArrayFrom(nil)")
+}
+
+func TestStringArrayScan(t *testing.T) {
+	var a StringArray
+	err := a.Scan(stringArrayLiteral)
+	maybePanic(err)
+	assertStringArray(t, a, "scanned string")
+
+	var b StringArray
+	err = b.Scan([]byte(stringArrayLiteral))
+	maybePanic(err)
+	assertStringArray(t, b, "scanned []byte")
+
+	var spaced StringArray
+	err = spaced.Scan(`{ a b , null ,\NULL, c\  }`)
+	maybePanic(err)
+	want := []String{StringFrom("a b"), NewString("", false), StringFrom("NULL"), StringFrom("c ")}
+	if !spaced.Valid || !reflect.DeepEqual(spaced.StringArray, want) {
+		t.Errorf("bad spaced array: %#v ≠ %#v\n", spaced.StringArray, want)
+	}
+
+	var empty StringArray
+	err = empty.Scan("{}")
+	maybePanic(err)
+	if !empty.Valid || empty.StringArray == nil || len(empty.StringArray) != 0 {
+		t.Errorf("bad empty array: %#v\n", empty)
+	}
+
+	var null StringArray
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullStringArray(t, null, "scanned null")
+
+	for _, bad := range []string{"", "a,b", "{a,}", "{,a}", `{"a}`, `{"a" b}`, `{a"b"}`, "{{a},{b}}", `{a\}`} {
+		invalid := StringArrayFrom([]string{"test"})
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("expected error scanning %q", bad)
+		}
+		assertNullStringArray(t, invalid, bad)
+	}
+
+	var wrong StringArray
+	if err := wrong.Scan(int64(42)); err == nil {
+		t.Error("expected error")
+	}
+	assertNullStringArray(t, wrong, "scanned int64")
+}
+
+func TestStringArrayValue(t *testing.T) {
+	a := NewStringArray(stringArrayValue, true)
+	v, err := a.Value()
+	maybePanic(err)
+	want := `{"test","a,b","say \"hi\"",NULL,"NULL",""}`
+	if v != want {
+		t.Errorf("bad array value: %v ≠ %v\n", v, want)
+	}
+
+	var back StringArray
+	maybePanic(back.Scan(v))
+	assertStringArray(t, back, "scanned Value()")
+
+	v, err = StringArrayFrom([]string{}).Value()
+	maybePanic(err)
+	if v != "{}" {
+		t.Errorf("bad empty array value: %v ≠ {}\n", v)
+	}
+
+	v, err = StringArrayFrom(nil).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null array value: %v ≠ nil\n", v)
+	}
+}
+
+func TestUnmarshalStringArray(t *testing.T) {
+	var a StringArray
+	err := json.Unmarshal(stringArrayJSON, &a)
+	maybePanic(err)
+	assertStringArray(t, a, "array json")
+
+	var empty StringArray
+	err = json.Unmarshal([]byte(`[]`), &empty)
+	maybePanic(err)
+	if !empty.Valid || len(empty.StringArray) != 0 {
+		t.Errorf("bad empty array json: %#v\n", empty)
+	}
+
+	var null StringArray
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullStringArray(t, null, "null json")
+
+	for _, bad := range []string{`"test"`, `[1]`, `{}`} {
+		var invalid StringArray
+		if err := json.Unmarshal([]byte(bad), &invalid); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+		assertNullStringArray(t, invalid, bad)
+	}
+}
+
+func TestMarshalStringArray(t *testing.T) {
+	data, err := json.Marshal(NewStringArray(stringArrayValue, true))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(stringArrayJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(StringArrayFrom([]string{}))
+	maybePanic(err)
+	assertJSONEquals(t, data, "[]", "empty json marshal")
+
+	data, err = json.Marshal(StringArrayFrom(nil))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestStringArrayIsZero(t *testing.T) {
+	a := StringArrayFrom([]string{"test"})
+	if a.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := StringArrayFrom(nil)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	empty := StringArrayFrom([]string{})
+	if !empty.IsZero() {
+		t.Errorf("IsZero() should be true for a valid empty value")
+	}
+	if !empty.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func assertStringArray(t *testing.T, a StringArray, from string) {
+	if !reflect.DeepEqual(a.StringArray, stringArrayValue) {
+		t.Errorf("bad %s array: %#v ≠ %#v\n", from, a.StringArray, stringArrayValue)
+	}
+	if !a.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullStringArray(t *testing.T, a StringArray, from string) {
+	if a.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -4,17 +4,72 @@
 package null
 
 import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
-	"github.com/lib/pq"
-	"reflect"
+	"strconv"
 	"time"
 )
 
-// String is a nullable string. It supports SQL and JSON serialization.
+// TimeFormat is the layout used to encode and decode Time values as JSON and text.
+// It defaults to RFC3339 with fractional seconds, which matches encoding/json's
+// own time.Time encoding, so that text and JSON round trips keep the exact instant.
+// Input that doesn't match TimeFormat is also tried with each of TimeFormats.
+// Changing it affects every Time in the process, so set it once during initialization.
+var TimeFormat = time.RFC3339Nano
+
+// TimeFormats are the layouts tried, in order, when decoding a Time from JSON, text or SQL
+// doesn't match TimeFormat, for upstreams that each send timestamps their own way.
+// Scan tries only these, since drivers don't use TimeFormat.
+// They are only used for decoding: Times are always encoded in TimeFormat, so that
+// there is a single setting for the output layout.
+// Input matching none of the layouts is an error listing them.
+// Like TimeFormat, it applies to every Time in the process.
+var TimeFormats = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// RFC3339Milli and RFC3339Micro are RFC3339 layouts with a fixed-width fractional second,
+// for use with SetTimeFormat. Unlike the default time.RFC3339Nano, which trims trailing zeros,
+// they always emit the same number of digits, so equal times encode identically.
+// Times are truncated, not rounded, to the layout's precision.
+const (
+	RFC3339Milli = "2006-01-02T15:04:05.000Z07:00"
+	RFC3339Micro = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// SetTimeFormat changes TimeFormat, the process-global layout for Time values.
+func SetTimeFormat(layout string) {
+	TimeFormat = layout
+}
+
+// UnixMilli controls how Time decodes JSON numbers.
+// By default numbers are Unix timestamps in seconds; if UnixMilli is true they are milliseconds.
+// Like TimeFormat, it applies to every Time in the process.
+var UnixMilli = false
+
+// Now returns the current time for IsPast and IsFuture. It defaults to time.Now;
+// tests can replace it with a fixed clock.
+var Now = time.Now
+
+// PositiveInfinity and NegativeInfinity are the times that Time.Scan uses for
+// Postgres 'infinity' and '-infinity' timestamps. They lie just outside the range
+// Postgres can store, so they can't collide with a real timestamp, and they sort
+// after and before every other time. Valid Times holding them encode as the JSON
+// strings "infinity" and "-infinity", and as those strings to SQL.
+var (
+	PositiveInfinity = time.Date(294277, time.January, 1, 0, 0, 0, 0, time.UTC)
+	NegativeInfinity = time.Date(-4713, time.November, 23, 0, 0, 0, 0, time.UTC)
+)
+
+// Time is a nullable time.Time. It supports SQL and JSON serialization.
 // It will marshal to null if null. Blank string input will be considered null.
 type Time struct {
-	pq.NullTime
+	sql.NullTime
 }
 
 // TimeFrom creates a new Time that will never be blank.
@@ -22,18 +77,69 @@ func TimeFrom(t time.Time) Time {
 	return NewTime(t, true)
 }
 
-// TimeFromPtr creates a new Time that be null if s is nil.
+// TimeFromPtr creates a new Time that will be null if t is nil.
+// The null Time holds the zero time.Time.
 func TimeFromPtr(t *time.Time) Time {
 	if t == nil {
-		return NewTime(time.Now(), false)
+		return NewTime(time.Time{}, false)
 	}
 	return NewTime(*t, true)
 }
 
+// TimeFromSQL creates a new Time with the same value and validity as t.
+// The embedded NullTime field converts back the other way.
+func TimeFromSQL(t sql.NullTime) Time {
+	return Time{NullTime: t}
+}
+
+// TimeFromUnix creates a new valid Time from a Unix timestamp in seconds.
+// Like time.Unix, the result is in the local time zone.
+func TimeFromUnix(sec int64) Time {
+	return TimeFrom(time.Unix(sec, 0))
+}
+
+// TimeFromUnixMilli creates a new valid Time from a Unix timestamp in milliseconds.
+func TimeFromUnixMilli(ms int64) Time {
+	return TimeFrom(time.UnixMilli(ms))
+}
+
+// TimeFromUnixNano creates a new valid Time from a Unix timestamp in nanoseconds.
+func TimeFromUnixNano(ns int64) Time {
+	return TimeFrom(time.Unix(0, ns))
+}
+
+// TimeFromUnixPtr creates a new Time from a Unix timestamp in seconds that will be null if sec is nil.
+func TimeFromUnixPtr(sec *int64) Time {
+	if sec == nil {
+		return NewTime(time.Time{}, false)
+	}
+	return TimeFromUnix(*sec)
+}
+
+// TimeFromString creates a new Time by parsing s with the given layout.
+// It will be null if s is blank, and an error is returned if s doesn't match layout.
+func TimeFromString(s, layout string) (Time, error) {
+	if s == "" {
+		return NewTime(time.Time{}, false), nil
+	}
+	t, err := time.Parse(layout, s)
+	return NewTime(t, err == nil), err
+}
+
+// MustTime is like TimeFromString but panics if s doesn't match layout.
+// It is intended for test fixtures and package-level variables.
+func MustTime(s, layout string) Time {
+	t, err := TimeFromString(s, layout)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
 // NewTime creates a new Time
 func NewTime(t time.Time, valid bool) Time {
 	return Time{
-		NullTime: pq.NullTime{
+		NullTime: sql.NullTime{
 			Time:  t,
 			Valid: valid,
 		},
@@ -41,42 +147,220 @@ func NewTime(t time.Time, valid bool) Time {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string and null input. Blank string input produces a null String.
-// It also supports unmarshalling a sql.NullString.
-func (t *Time) UnmarshalJSON(data []byte) error {
-	var err error
-	var v interface{}
-	json.Unmarshal(data, &v)
-	switch x := v.(type) {
-	case time.Time:
-		t.Time = x
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &t.NullTime)
-	case nil:
+// It supports string input in TimeFormat or any of TimeFormats, Unix timestamp numbers, and null input.
+// Blank string input produces a null Time.
+// Numbers are seconds since the Unix epoch, or milliseconds if UnixMilli is set;
+// they must be integers, and within the years 0 to 9999.
+// Note that 0 decodes to a valid Time at the epoch, not a null Time.
+// It also supports unmarshalling a sql.NullTime, which is null unless its Valid field is true.
+func (t *Time) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Time")
+	switch data = bytes.TrimSpace(data); kindOf(data) {
+	case jsonNull:
 		t.Valid = false
 		return nil
+	case jsonString:
+		var str string
+		if str, err = unquoteJSON(data); err != nil {
+			break
+		}
+		if str == "" {
+			t.Valid = false
+			return nil
+		}
+		t.Time, err = parseTime(str)
+	case jsonNumber:
+		t.Time, err = parseUnixJSON(data)
+	case jsonObject:
+		t.NullTime = sql.NullTime{}
+		err = json.Unmarshal(data, &t.NullTime)
+		t.Valid = err == nil && t.Valid && !t.Time.IsZero()
+		return err
 	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Time", reflect.TypeOf(v).Name())
+		err = jsonTypeError(data, "null.Time")
 	}
 	t.Valid = err == nil && !t.Time.IsZero()
 	return err
 }
 
+// Unix timestamps decoded from JSON must fall within the years 0 to 9999,
+// the range RFC 3339 timestamps can express.
+var (
+	minUnixJSON = time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+	maxUnixJSON = time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC).Unix()
+)
+
+// parseUnixJSON parses the JSON number data as a Unix timestamp in seconds,
+// or milliseconds if UnixMilli is set. Timestamps must be integers within the years 0 to 9999.
+func parseUnixJSON(data []byte) (time.Time, error) {
+	n, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		if bytes.ContainsAny(data, ".eE") {
+			return time.Time{}, fmt.Errorf("json: cannot unmarshal number %s into Go value of type null.Time: Unix timestamps must be integers", data)
+		}
+		return time.Time{}, fmt.Errorf("json: cannot unmarshal number %s into Go value of type null.Time: Unix timestamp out of range", data)
+	}
+	min, max := minUnixJSON, maxUnixJSON
+	if UnixMilli {
+		min, max = min*1000, max*1000+999
+	}
+	if n < min || n > max {
+		return time.Time{}, fmt.Errorf("json: cannot unmarshal number %s into Go value of type null.Time: Unix timestamp out of range", data)
+	}
+	if UnixMilli {
+		return time.UnixMilli(n), nil
+	}
+	return time.Unix(n, 0), nil
+}
+
+// UnmarshalJSONStrict is like UnmarshalJSON, but returns an error for input that
+// would produce a null Time, such as null or a blank string.
+// Call it from a custom UnmarshalJSON to reject missing values for required fields.
+func (t *Time) UnmarshalJSONStrict(data []byte) error {
+	if err := t.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	if !t.Valid {
+		return strictNullError(data, "null.Time")
+	}
+	return nil
+}
+
+// UnmarshalJSONValidate is like UnmarshalJSON, but also calls validate with the decoded value.
+// If validate returns an error, this Time is set to null and the error is returned wrapped.
+// Input that produces a null Time is not validated.
+func (t *Time) UnmarshalJSONValidate(data []byte, validate func(time.Time) error) error {
+	if err := t.UnmarshalJSON(data); err != nil || !t.Valid {
+		return err
+	}
+	if err := validate(t.Time); err != nil {
+		t.Valid = false
+		return validateError(data, "null.Time", err)
+	}
+	return nil
+}
+
+// UnmarshalJSONIn is like UnmarshalJSON, but converts the decoded time to loc.
+// The instant is kept and only the location changes, whatever offset the input had.
+func (t *Time) UnmarshalJSONIn(data []byte, loc *time.Location) error {
+	if err := t.UnmarshalJSON(data); err != nil || !t.Valid {
+		return err
+	}
+	t.Time = t.Time.In(loc)
+	return nil
+}
+
 // MarshalJSON implements json.Marshaler.
-// It will encode null if this Time is null.
+// It will encode null if this Time is null (or the zero instant if NullZero is set),
+// "infinity" or "-infinity" for the infinity sentinels, and a string in TimeFormat otherwise.
 func (t Time) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(make([]byte, 0, len(TimeFormat)+16))
+}
+
+// AppendJSON appends the JSON encoding of this Time to dst, as MarshalJSON would encode it.
+// Encoders can reuse dst across calls to avoid allocating for every value.
+func (t Time) AppendJSON(dst []byte) ([]byte, error) {
+	if !t.Valid {
+		if NullZero {
+			return appendTimeJSON(dst, time.Time{})
+		}
+		return append(dst, "null"...), nil
+	}
+	return appendTimeJSON(dst, t.Time)
+}
+
+// appendTimeJSON appends t to dst as a JSON string in TimeFormat.
+// The infinity sentinels encode as "infinity" and "-infinity".
+// It formats straight into dst, and only falls back to json.Marshal
+// when the layout produces bytes that encoding/json would escape.
+func appendTimeJSON(dst []byte, t time.Time) ([]byte, error) {
+	if inf, ok := formatInfinity(t); ok {
+		return appendJSONString(dst, inf)
+	}
+	start := len(dst)
+	dst = append(dst, '"')
+	dst = t.AppendFormat(dst, TimeFormat)
+	for _, c := range dst[start+1:] {
+		if jsonEscapes(c) {
+			return appendJSONString(dst[:start], string(dst[start+1:]))
+		}
+	}
+	return append(dst, '"'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Time is null, and a timestamp in TimeFormat otherwise,
+// which UnmarshalText accepts.
+func (t Time) MarshalText() ([]byte, error) {
 	if !t.Valid {
-		return []byte("null"), nil
+		return nullText(), nil
+	}
+	return []byte(formatTime(t.Time)), nil
+}
+
+// formatTime formats t in TimeFormat, or as "infinity" or "-infinity" for the infinity sentinels.
+func formatTime(t time.Time) string {
+	if inf, ok := formatInfinity(t); ok {
+		return inf
 	}
-	return json.Marshal(t.Time)
+	return t.Format(TimeFormat)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Time if the input is a blank string.
+// It will unmarshal to a null Time if the input is blank, including whitespace-only input,
+// or matches NullText.
+// It will return an error if the input is not a timestamp in TimeFormat or any of TimeFormats,
+// "infinity", or "-infinity".
 func (t *Time) UnmarshalText(text []byte) error {
-	t.Time = t.Time
-	t.Valid = !t.Time.IsZero()
-	return nil
+	str := string(text)
+	if trimmed := bytes.TrimSpace(text); string(trimmed) == "null" || isNullText(trimmed) {
+		t.Valid = false
+		return nil
+	}
+	var err error
+	t.Time, err = parseTime(str)
+	t.Valid = err == nil
+	return err
+}
+
+// Scan implements sql.Scanner.
+// In addition to time.Time, it supports the string and []byte values
+// some drivers return for DATETIME columns, in any of TimeFormats.
+// Text with an explicit offset, such as "2021-01-02T15:04:05-05:00", keeps that offset
+// rather than being converted to UTC, so the value writes back to the database unchanged.
+// A "Z" suffix, or a layout without a zone, gives UTC.
+// The Postgres values "infinity" and "-infinity" scan to valid Times holding
+// PositiveInfinity and NegativeInfinity.
+func (t *Time) Scan(value interface{}) error {
+	var err error
+	switch x := value.(type) {
+	case time.Time:
+		t.Time = x
+	case []byte:
+		t.Time, err = scanTime(string(x))
+	case string:
+		t.Time, err = scanTime(x)
+	case nil:
+		t.Valid = false
+		return nil
+	default:
+		return t.NullTime.Scan(value)
+	}
+	t.Valid = err == nil
+	return err
+}
+
+// Value implements driver.Valuer.
+// It returns a time.Time, "infinity" or "-infinity" for the infinity sentinels,
+// or nil if this Time is null.
+func (t Time) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	if inf, ok := formatInfinity(t.Time); ok {
+		return inf, nil
+	}
+	return t.Time, nil
 }
 
 // SetValid changes this Time's value and also sets it to be non-null.
@@ -85,6 +369,30 @@ func (t *Time) SetValid(v time.Time) {
 	t.Valid = true
 }
 
+// Set changes this Time's value and validity at once.
+// Unlike SetValid, it can keep a value while marking this Time null.
+func (t *Time) Set(v time.Time, valid bool) {
+	t.Time = v
+	t.Valid = valid
+}
+
+// SetNull sets this Time to null, resetting its value to zero
+// so that stale data doesn't show through the Time field.
+func (t *Time) SetNull() {
+	t.Time = time.Time{}
+	t.Valid = false
+}
+
+// SetValidIf sets this Time to v if cond is true, and to null otherwise.
+// Unlike Set, it resets the value to zero when cond is false.
+func (t *Time) SetValidIf(v time.Time, cond bool) {
+	if !cond {
+		t.SetNull()
+		return
+	}
+	t.SetValid(v)
+}
+
 // Ptr returns a pointer to this String's value, or a nil pointer if this String is null.
 func (t Time) Ptr() *time.Time {
 	if !t.Valid {
@@ -93,8 +401,255 @@ func (t Time) Ptr() *time.Time {
 	return &t.Time
 }
 
-// IsZero returns true for null or empty strings, for future omitempty support. (Go 1.4?)
-// Will return false s if blank but non-null.
-func (t Time) IsZero() bool {
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (t Time) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (t Time) ValueOr(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+	return t.Time
+}
+
+// Equal returns true if both times have the same value or are both null.
+// Like time.Time.Equal, it ignores differences in location and monotonic clock readings.
+func (t Time) Equal(other Time) bool {
+	return t.Valid == other.Valid && (!t.Valid || t.Time.Equal(other.Time))
+}
+
+// Compare returns -1 if this Time sorts before other, 1 if it sorts after, and 0 if they are equal,
+// for use with sort and slices.SortFunc. Valid values are ordered by instant, ignoring location.
+// Null values are equal to each other and sort before every valid value, or after if NullsLast is set.
+func (t Time) Compare(other Time) int {
+	if c, ok := compareNull(t.Valid, other.Valid); ok {
+		return c
+	}
+	switch {
+	case t.Time.Before(other.Time):
+		return -1
+	case t.Time.After(other.Time):
+		return 1
+	}
+	return 0
+}
+
+// Changed returns true if this Time differs from old: if one is null and the other isn't,
+// or if both are valid with different values. It is the inverse of Equal.
+func (t Time) Changed(old Time) bool {
+	return !t.Equal(old)
+}
+
+// Or returns this Time if it is valid, otherwise other.
+func (t Time) Or(other Time) Time {
+	if t.Valid {
+		return t
+	}
+	return other
+}
+
+// Merge copies patch into this Time if patch is valid, and otherwise leaves it unchanged,
+// for applying partial updates where a null field means "keep the current value".
+func (t *Time) Merge(patch Time) {
+	if patch.Valid {
+		*t = patch
+	}
+}
+
+// Map returns a valid Time holding f applied to this Time's value,
+// or this Time unchanged if it is null. f is not called for a null Time.
+func (t Time) Map(f func(time.Time) time.Time) Time {
+	if !t.Valid {
+		return t
+	}
+	return TimeFrom(f(t.Time))
+}
+
+// Format returns a String holding this Time formatted with layout, as with time.Time.Format,
+// or a null String if this Time is null.
+func (t Time) Format(layout string) String {
+	if !t.Valid {
+		return NewString("", false)
+	}
+	return StringFrom(t.Time.Format(layout))
+}
+
+// After reports whether this Time is after u.
+// It returns false if either Time is null.
+func (t Time) After(u Time) bool {
+	return t.Valid && u.Valid && t.Time.After(u.Time)
+}
+
+// Before reports whether this Time is before u.
+// It returns false if either Time is null.
+func (t Time) Before(u Time) bool {
+	return t.Valid && u.Valid && t.Time.Before(u.Time)
+}
+
+// IsPast reports whether this Time is before the current time, as given by Now.
+// It returns false if this Time is null.
+func (t Time) IsPast() bool {
+	return t.Valid && t.Time.Before(Now())
+}
+
+// IsFuture reports whether this Time is after the current time, as given by Now.
+// It returns false if this Time is null.
+func (t Time) IsFuture() bool {
+	return t.Valid && t.Time.After(Now())
+}
+
+// Sub returns the duration t-u and true, or 0 and false if either Time is null.
+func (t Time) Sub(u Time) (time.Duration, bool) {
+	if !t.Valid || !u.Valid {
+		return 0, false
+	}
+	return t.Time.Sub(u.Time), true
+}
+
+// Add returns this Time plus d, as with time.Time.Add.
+// A null Time is returned unchanged.
+func (t Time) Add(d time.Duration) Time {
+	if !t.Valid {
+		return t
+	}
+	return TimeFrom(t.Time.Add(d))
+}
+
+// AddDate returns this Time plus the given number of years, months and days, as with time.Time.AddDate.
+// The wall clock time is kept across daylight saving changes. A null Time is returned unchanged.
+func (t Time) AddDate(years, months, days int) Time {
+	if !t.Valid {
+		return t
+	}
+	return TimeFrom(t.Time.AddDate(years, months, days))
+}
+
+// Truncate returns this Time rounded down to a multiple of d, as with time.Time.Truncate.
+// A null Time is returned unchanged.
+func (t Time) Truncate(d time.Duration) Time {
+	if !t.Valid {
+		return t
+	}
+	return TimeFrom(t.Time.Truncate(d))
+}
+
+// In returns this Time with its location set to loc, as with time.Time.In.
+// A null Time is returned unchanged.
+func (t Time) In(loc *time.Location) Time {
+	if !t.Valid {
+		return t
+	}
+	return TimeFrom(t.Time.In(loc))
+}
+
+// UTC returns this Time with its location set to UTC.
+// A null Time is returned unchanged.
+func (t Time) UTC() Time {
+	return t.In(time.UTC)
+}
+
+// Local returns this Time with its location set to local time.
+// A null Time is returned unchanged.
+func (t Time) Local() Time {
+	return t.In(time.Local)
+}
+
+// String implements fmt.Stringer.
+// It returns this Time's value in RFC3339 format, or NullDisplay if null.
+func (t Time) String() string {
+	if !t.Valid {
+		return NullDisplay
+	}
+	return t.Time.Format(time.RFC3339)
+}
+
+// IsNull returns true if this Time is null.
+func (t Time) IsNull() bool {
+	return !t.Valid
+}
+
+// Null is the same as IsNull.
+func (t Time) Null() bool {
 	return !t.Valid
 }
+
+// IsValid returns true if this Time is not null.
+func (t Time) IsValid() bool {
+	return t.Valid
+}
+
+// IsZero returns true for null Times and for valid ones holding the zero instant, for omitempty support.
+// Use IsValid to tell a null Time from a valid zero one.
+func (t Time) IsZero() bool {
+	return !t.Valid || t.Time.IsZero()
+}
+
+// parseTime parses s using TimeFormat, falling back to each of TimeFormats.
+// It also accepts "infinity" and "-infinity", so the infinity sentinels round trip.
+func parseTime(s string) (time.Time, error) {
+	if t, ok := parseInfinity(s); ok {
+		return t, nil
+	}
+	t, err := time.Parse(TimeFormat, s)
+	if err == nil {
+		return t, nil
+	}
+	if t, ok := parseTimeFormats(s); ok {
+		return t, nil
+	}
+	layouts := []string{TimeFormat}
+	for _, layout := range TimeFormats {
+		if layout != TimeFormat {
+			layouts = append(layouts, layout)
+		}
+	}
+	return time.Time{}, fmt.Errorf("time %q matches none of the layouts %q: %w", s, layouts, err)
+}
+
+// scanTime parses s using the first of TimeFormats that matches.
+func scanTime(s string) (time.Time, error) {
+	if t, ok := parseInfinity(s); ok {
+		return t, nil
+	}
+	if t, ok := parseTimeFormats(s); ok {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("null: cannot scan %q into null.Time: it matches none of the layouts %q", s, TimeFormats)
+}
+
+// parseTimeFormats parses s using the first of TimeFormats that matches.
+func parseTimeFormats(s string) (time.Time, bool) {
+	for _, layout := range TimeFormats {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseInfinity returns the sentinel for the Postgres timestamps "infinity" and "-infinity".
+func parseInfinity(s string) (time.Time, bool) {
+	switch s {
+	case "infinity":
+		return PositiveInfinity, true
+	case "-infinity":
+		return NegativeInfinity, true
+	}
+	return time.Time{}, false
+}
+
+// formatInfinity is the inverse of parseInfinity.
+func formatInfinity(t time.Time) (string, bool) {
+	switch {
+	case t.Equal(PositiveInfinity):
+		return "infinity", true
+	case t.Equal(NegativeInfinity):
+		return "-infinity", true
+	}
+	return "", false
+}
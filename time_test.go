@@ -0,0 +1,1057 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	timeString   = "2012-12-21T21:21:21Z"
+	timeJSON     = []byte(`"` + timeString + `"`)
+	nullTimeJSON = []byte(`{"Time":"2012-12-21T21:21:21Z","Valid":true}`)
+	timeValue    = time.Date(2012, time.December, 21, 21, 21, 21, 0, time.UTC)
+)
+
+func TestTimeFrom(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	assertTime(t, ti, "TimeFrom() time.Time")
+}
+
+func TestTimeFromPtr(t *testing.T) {
+	ti := TimeFromPtr(&timeValue)
+	assertTime(t, ti, "TimeFromPtr() time")
+
+	null := TimeFromPtr(nil)
+	assertNullTime(t, null, "TimeFromPtr(nil)")
+	if !null.Time.IsZero() {
+		t.Errorf("TimeFromPtr(nil) should hold the zero time, got %v", null.Time)
+	}
+}
+
+func TestTimeFromSQL(t *testing.T) {
+	v := TimeFromSQL(sql.NullTime{Time: timeValue, Valid: true})
+	assertTime(t, v, "TimeFromSQL()")
+	if v.NullTime != (sql.NullTime{Time: timeValue, Valid: true}) {
+		t.Errorf("bad NullTime round trip: %v", v.NullTime)
+	}
+
+	null := TimeFromSQL(sql.NullTime{})
+	assertNullTime(t, null, "TimeFromSQL() null")
+	if null.NullTime != (sql.NullTime{}) {
+		t.Errorf("bad null NullTime round trip: %v", null.NullTime)
+	}
+}
+
+func TestTimeFromUnix(t *testing.T) {
+	sec := timeValue.Unix()
+	assertTime(t, TimeFromUnix(sec), "TimeFromUnix()")
+	assertTime(t, TimeFromUnixMilli(timeValue.UnixMilli()), "TimeFromUnixMilli()")
+	assertTime(t, TimeFromUnixNano(timeValue.UnixNano()), "TimeFromUnixNano()")
+	assertTime(t, TimeFromUnixPtr(&sec), "TimeFromUnixPtr()")
+	assertNullTime(t, TimeFromUnixPtr(nil), "TimeFromUnixPtr(nil)")
+
+	precise := timeValue.Add(123456789 * time.Nanosecond)
+	if got := TimeFromUnixMilli(precise.UnixMilli()).Time; !got.Equal(timeValue.Add(123 * time.Millisecond)) {
+		t.Errorf("TimeFromUnixMilli() = %v, want %v", got, timeValue.Add(123*time.Millisecond))
+	}
+	if got := TimeFromUnixNano(precise.UnixNano()).Time; !got.Equal(precise) {
+		t.Errorf("TimeFromUnixNano() = %v, want %v", got, precise)
+	}
+
+	epoch := TimeFromUnix(0)
+	if !epoch.Valid || !epoch.Time.Equal(time.Unix(0, 0)) {
+		t.Errorf("TimeFromUnix(0) = %v, want valid epoch", epoch)
+	}
+}
+
+func TestTimeFromString(t *testing.T) {
+	ti, err := TimeFromString("2012-12-21 21:21:21", "2006-01-02 15:04:05")
+	maybePanic(err)
+	assertTime(t, ti, "TimeFromString()")
+
+	null, err := TimeFromString("", time.RFC3339)
+	maybePanic(err)
+	assertNullTime(t, null, "TimeFromString(\"\")")
+
+	invalid, err := TimeFromString(timeString, "2006-01-02")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, invalid, "TimeFromString() invalid")
+}
+
+func TestMustTime(t *testing.T) {
+	assertTime(t, MustTime("2012-12-21 21:21:21", "2006-01-02 15:04:05"), "MustTime()")
+	assertNullTime(t, MustTime("", time.RFC3339), "MustTime(\"\")")
+	assertPanics(t, func() { MustTime(timeString, "2006-01-02") }, "MustTime() invalid")
+}
+
+func TestUnmarshalTimeJSON(t *testing.T) {
+	var ti Time
+	err := json.Unmarshal(timeJSON, &ti)
+	maybePanic(err)
+	assertTime(t, ti, "UnmarshalJSON() json")
+
+	var nt Time
+	err = json.Unmarshal(nullTimeJSON, &nt)
+	maybePanic(err)
+	assertTime(t, nt, "sql.NullTime json")
+
+	invalidObj := TimeFrom(timeValue)
+	err = json.Unmarshal([]byte(`{"Time":"2012-12-21T21:21:21Z","Valid":false}`), &invalidObj)
+	maybePanic(err)
+	assertNullTime(t, invalidObj, "sql.NullTime json with Valid false")
+
+	var blank Time
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullTime(t, blank, "blank string json")
+
+	var null Time
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullTime(t, null, "null json")
+
+	var invalid Time
+	err = invalid.UnmarshalJSON([]byte(`"hello world"`))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, invalid, "invalid string json")
+
+	var badType Time
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, badType, "wrong type json")
+}
+
+func TestTimeUnmarshalJSONStrict(t *testing.T) {
+	var v Time
+	err := v.UnmarshalJSONStrict(timeJSON)
+	maybePanic(err)
+	assertTime(t, v, "strict json")
+
+	var null Time
+	if err := null.UnmarshalJSONStrict(nullJSON); err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, null, "strict null json")
+
+	var blank Time
+	if err := blank.UnmarshalJSONStrict(blankStringJSON); err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, blank, "strict blank string json")
+}
+
+func TestTimeUnmarshalJSONValidate(t *testing.T) {
+	validate := func(v time.Time) error {
+		if v.After(timeValue) {
+			return errTooBig
+		}
+		return nil
+	}
+
+	var v Time
+	err := v.UnmarshalJSONValidate(timeJSON, validate)
+	assertValidated(t, err, nil, "validated json")
+	assertTime(t, v, "validated json")
+
+	var invalid Time
+	err = invalid.UnmarshalJSONValidate([]byte(`"2012-12-22T00:00:00Z"`), validate)
+	assertValidated(t, err, errTooBig, "invalid json")
+	assertNullTime(t, invalid, "invalid json")
+
+	var null Time
+	err = null.UnmarshalJSONValidate(nullJSON, func(time.Time) error { return errTooBig })
+	assertValidated(t, err, nil, "null json")
+	assertNullTime(t, null, "null json")
+}
+
+func TestTimeUnmarshalJSONIn(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	for _, data := range []string{`"2012-12-21T21:21:21+00:00"`, `"2012-12-21T16:21:21-05:00"`} {
+		var ti Time
+		err := ti.UnmarshalJSONIn([]byte(data), est)
+		maybePanic(err)
+		assertTime(t, TimeFrom(ti.Time.UTC()), data)
+		if ti.Time.Location() != est {
+			t.Errorf("bad %s location: %v ≠ %v\n", data, ti.Time.Location(), est)
+		}
+		if h, m, s := ti.Time.Clock(); h != 16 || m != 21 || s != 21 {
+			t.Errorf("bad %s wall clock: %02d:%02d:%02d ≠ 16:21:21\n", data, h, m, s)
+		}
+	}
+
+	null := TimeFrom(timeValue)
+	err := null.UnmarshalJSONIn(nullJSON, est)
+	maybePanic(err)
+	assertNullTime(t, null, "null json")
+}
+
+func TestUnmarshalTimeJSONForms(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"string", `"2012-12-21T21:21:21Z"`, true},
+		{"escaped string", `"2012-12-21T21:21:21\u005a"`, true},
+		{"padded string", " \n\"2012-12-21T21:21:21Z\"\t", true},
+		{"number", `1356124881`, true},
+		{"padded number", " 1356124881\n", true},
+		{"object", `{"Time":"2012-12-21T21:21:21Z","Valid":true}`, true},
+		{"padded null", ` null `, false},
+	}
+	for _, tc := range tests {
+		var ti Time
+		err := ti.UnmarshalJSON([]byte(tc.input))
+		maybePanic(err)
+		if tc.valid {
+			assertTime(t, ti, tc.name)
+		} else {
+			assertNullTime(t, ti, tc.name)
+		}
+	}
+
+	for _, input := range []string{`nul`, `true`, `[]`, `"2012-12-21`, `12x`, `1356124881.5`} {
+		var ti Time
+		if err := ti.UnmarshalJSON([]byte(input)); err == nil {
+			t.Errorf("expected error for %s", input)
+		}
+		assertNullTime(t, ti, input)
+	}
+}
+
+func TestUnmarshalTimeUnix(t *testing.T) {
+	var ti Time
+	err := json.Unmarshal([]byte(`1356124881`), &ti)
+	maybePanic(err)
+	assertTime(t, ti, "unix seconds json")
+
+	var epoch Time
+	err = json.Unmarshal([]byte(`0`), &epoch)
+	maybePanic(err)
+	if !epoch.Valid || !epoch.Time.Equal(time.Unix(0, 0)) {
+		t.Errorf("bad %s time: %v (valid: %t)\n", "unix epoch json", epoch.Time, epoch.Valid)
+	}
+
+	var null Time
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullTime(t, null, "null json")
+
+	max := time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)
+	var last Time
+	err = json.Unmarshal([]byte(strconv.FormatInt(max.Unix(), 10)), &last)
+	maybePanic(err)
+	if !last.Valid || !last.Time.Equal(max) {
+		t.Errorf("bad last supported unix time: %v", last.Time)
+	}
+
+	for _, in := range []string{`1e30`, `253402300800`, `-62167219201`, `9223372036854775808`, `1.9`, `1356124881.5`, `1e3`} {
+		ti := TimeFrom(timeValue)
+		err := json.Unmarshal([]byte(in), &ti)
+		if err == nil {
+			t.Errorf("expected error for %s, got %v", in, ti.Time)
+		}
+		assertNullTime(t, ti, "unix json "+in)
+	}
+}
+
+func TestUnmarshalTimeUnixMilli(t *testing.T) {
+	defer func(old bool) { UnixMilli = old }(UnixMilli)
+	UnixMilli = true
+
+	var ti Time
+	err := json.Unmarshal([]byte(`1356124881000`), &ti)
+	maybePanic(err)
+	assertTime(t, ti, "unix milliseconds json")
+
+	for _, in := range []string{`1.9`, `253402300800000`, `1e30`} {
+		bad := TimeFrom(timeValue)
+		err := json.Unmarshal([]byte(in), &bad)
+		if err == nil {
+			t.Errorf("expected error for %s, got %v", in, bad.Time)
+		}
+		assertNullTime(t, bad, "unix milliseconds json "+in)
+	}
+
+	var null Time
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullTime(t, null, "null json")
+}
+
+func TestTextUnmarshalTime(t *testing.T) {
+	var ti Time
+	err := ti.UnmarshalText([]byte(timeString))
+	maybePanic(err)
+	assertTime(t, ti, "UnmarshalText() time")
+
+	var blank Time
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullTime(t, blank, "blank text")
+
+	var spaces Time
+	err = spaces.UnmarshalText([]byte("   "))
+	maybePanic(err)
+	assertNullTime(t, spaces, "whitespace-only text")
+
+	var null Time
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullTime(t, null, `"null" text`)
+
+	var invalid Time
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, invalid, "invalid text")
+}
+
+func TestTextUnmarshalTimeRoundTrip(t *testing.T) {
+	in := TimeFrom(time.Date(2012, time.December, 21, 21, 21, 21, 123456789, time.FixedZone("test", -5*60*60)))
+	var out Time
+	err := out.UnmarshalText([]byte(in.Time.Format(time.RFC3339Nano)))
+	maybePanic(err)
+	if !out.Valid || !out.Time.Equal(in.Time) {
+		t.Errorf("bad round trip: %v ≠ %v\n", out.Time, in.Time)
+	}
+}
+
+func TestMarshalTime(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	data, err := json.Marshal(ti)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(timeJSON), "non-empty json marshal")
+
+	null := TimeFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, `null`, "null json marshal")
+}
+
+func TestMarshalTimeText(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	data, err := ti.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, timeString, "non-empty text marshal")
+
+	in := TimeFrom(time.Date(2012, time.December, 21, 21, 21, 21, 123456789, time.FixedZone("test", -5*60*60)))
+	data, err = in.MarshalText()
+	maybePanic(err)
+	var out Time
+	err = out.UnmarshalText(data)
+	maybePanic(err)
+	if !out.Valid || !out.Time.Equal(in.Time) {
+		t.Errorf("bad text round trip: %v ≠ %v\n", out.Time, in.Time)
+	}
+
+	null := TimeFromPtr(nil)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var nullOut Time
+	err = nullOut.UnmarshalText(data)
+	maybePanic(err)
+	assertNullTime(t, nullOut, "null text round trip")
+}
+
+func TestTimeFormat(t *testing.T) {
+	defer SetTimeFormat(TimeFormat)
+	SetTimeFormat("2006-01-02 15:04:05")
+
+	ti := TimeFrom(timeValue)
+	data, err := json.Marshal(ti)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21 21:21:21"`, "custom format json marshal")
+
+	var custom Time
+	err = json.Unmarshal(data, &custom)
+	maybePanic(err)
+	assertTime(t, custom, "custom format json")
+
+	var fallback Time
+	err = json.Unmarshal(timeJSON, &fallback)
+	maybePanic(err)
+	assertTime(t, fallback, "RFC3339 fallback json")
+
+	var text Time
+	err = text.UnmarshalText([]byte("2012-12-21 21:21:21"))
+	maybePanic(err)
+	assertTime(t, text, "custom format text")
+
+	TimeFormat = time.RFC1123
+	data, err = json.Marshal(ti)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"Fri, 21 Dec 2012 21:21:21 UTC"`, "assigned format json marshal")
+	data, err = ti.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "Fri, 21 Dec 2012 21:21:21 UTC", "assigned format text marshal")
+}
+
+func TestTimeFormats(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want time.Time
+	}{
+		{"2012-12-21T21:21:21Z", timeValue},
+		{"2012-12-21T21:21:21.5+09:00", time.Date(2012, time.December, 21, 21, 21, 21, 5e8, time.FixedZone("", 9*60*60))},
+		{"2012-12-21 21:21:21", timeValue},
+		{"2012-12-21 21:21:21.123456", timeValue.Add(123456 * time.Microsecond)},
+		{"2012-12-21", time.Date(2012, time.December, 21, 0, 0, 0, 0, time.UTC)},
+	} {
+		var fromJSON, fromText, scanned Time
+		err := json.Unmarshal([]byte(strconv.Quote(tc.in)), &fromJSON)
+		maybePanic(err)
+		err = fromText.UnmarshalText([]byte(tc.in))
+		maybePanic(err)
+		err = scanned.Scan(tc.in)
+		maybePanic(err)
+		for _, ti := range []Time{fromJSON, fromText, scanned} {
+			if !ti.Valid || !ti.Time.Equal(tc.want) {
+				t.Errorf("bad time from %q: %v ≠ %v", tc.in, ti.Time, tc.want)
+			}
+		}
+	}
+
+	// encoding uses TimeFormat, keeping fractional seconds
+	data, err := json.Marshal(TimeFrom(timeValue.Add(time.Millisecond)))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21T21:21:21.001Z"`, "TimeFormats json marshal")
+
+	const bad = "21/12/2012"
+	var invalid Time
+	err = json.Unmarshal([]byte(strconv.Quote(bad)), &invalid)
+	if err == nil || !strings.Contains(err.Error(), strconv.Quote(TimeFormats[len(TimeFormats)-1])) {
+		t.Errorf("error should list the layouts tried: %v", err)
+	}
+	var parseErr *time.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("error should unwrap to *time.ParseError: %v", err)
+	}
+	assertNullTime(t, invalid, "unrecognized json")
+	err = invalid.UnmarshalText([]byte(bad))
+	if err == nil || !strings.Contains(err.Error(), strconv.Quote(TimeFormats[0])) {
+		t.Errorf("error should list the layouts tried: %v", err)
+	}
+	err = invalid.Scan(bad)
+	if err == nil || !strings.Contains(err.Error(), strconv.Quote(TimeFormats[1])) {
+		t.Errorf("error should list the layouts tried: %v", err)
+	}
+	assertNullTime(t, invalid, "unrecognized scan")
+
+	defer func(formats []string) { TimeFormats = formats }(TimeFormats)
+	TimeFormats = []string{"02/01/2006", time.RFC1123}
+	for _, in := range []string{bad, "Fri, 21 Dec 2012 00:00:00 UTC"} {
+		var custom Time
+		err = custom.UnmarshalText([]byte(in))
+		maybePanic(err)
+		err = custom.Scan(in)
+		maybePanic(err)
+		if !custom.Time.Equal(time.Date(2012, time.December, 21, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("bad time from custom layout %q: %v", in, custom.Time)
+		}
+	}
+	var scanned Time
+	if err := scanned.Scan(timeString); err == nil {
+		t.Error("Scan should only try TimeFormats")
+	}
+
+	data, err = json.Marshal(TimeFrom(timeValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(timeJSON), "custom TimeFormats json marshal")
+	data, err = TimeFrom(timeValue).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, timeString, "custom TimeFormats text marshal")
+}
+
+func TestTimeValueOrZero(t *testing.T) {
+	valid := TimeFrom(timeValue)
+	if !valid.ValueOrZero().Equal(timeValue) {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewTime(timeValue, false)
+	if !invalid.ValueOrZero().IsZero() {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestTimeEqual(t *testing.T) {
+	a := NewTime(timeValue, false)
+	b := NewTime(timeValue, false)
+	assertTimeEqualIsTrue(t, a, b)
+
+	a = NewTime(timeValue, false)
+	b = NewTime(timeValue.Add(time.Hour), false)
+	assertTimeEqualIsTrue(t, a, b)
+
+	a = NewTime(timeValue, true)
+	b = NewTime(timeValue, true)
+	assertTimeEqualIsTrue(t, a, b)
+
+	// same instant in a different location
+	a = NewTime(timeValue, true)
+	b = NewTime(timeValue.In(time.FixedZone("test", -5*60*60)), true)
+	assertTimeEqualIsTrue(t, a, b)
+
+	// monotonic clock readings are ignored
+	now := time.Now()
+	a = NewTime(now, true)
+	b = NewTime(now.Round(0), true)
+	assertTimeEqualIsTrue(t, a, b)
+
+	a = NewTime(timeValue, true)
+	b = NewTime(timeValue, false)
+	assertTimeEqualIsFalse(t, a, b)
+
+	a = NewTime(timeValue, true)
+	b = NewTime(timeValue.Add(time.Hour), true)
+	assertTimeEqualIsFalse(t, a, b)
+}
+
+func TestTimeValueOr(t *testing.T) {
+	def := timeValue.Add(time.Hour)
+	if v := TimeFrom(timeValue).ValueOr(def); !v.Equal(timeValue) {
+		t.Error("unexpected ValueOr", v)
+	}
+	if v := NewTime(timeValue, false).ValueOr(def); !v.Equal(def) {
+		t.Error("unexpected ValueOr", v)
+	}
+}
+
+func TestTimeOr(t *testing.T) {
+	valid := TimeFrom(timeValue)
+	null := NewTime(timeValue.Add(time.Hour), false)
+	assertTime(t, valid.Or(TimeFrom(timeValue.Add(time.Hour))), "valid.Or()")
+	assertTime(t, null.Or(valid), "null.Or(valid)")
+	assertNullTime(t, null.Or(null), "null.Or(null)")
+	assertTime(t, null.Or(null).Or(valid).Or(TimeFrom(timeValue.Add(time.Hour))), "chained Or()")
+}
+
+func TestTimeMap(t *testing.T) {
+	assertTime(t, TimeFrom(timeValue.Add(-time.Hour)).Map(func(v time.Time) time.Time { return v.Add(time.Hour) }), "Map()")
+
+	called := false
+	null := NewTime(timeValue, false).Map(func(v time.Time) time.Time {
+		called = true
+		return v
+	})
+	assertNullTime(t, null, "null Map()")
+	if called {
+		t.Error("Map() should not call f for a null Time")
+	}
+}
+
+func TestTimeScan(t *testing.T) {
+	var ti Time
+	err := ti.Scan(timeValue)
+	maybePanic(err)
+	assertTime(t, ti, "scanned time")
+
+	var str Time
+	err = str.Scan(timeString)
+	maybePanic(err)
+	assertTime(t, str, "scanned RFC3339 string")
+
+	var bytes Time
+	err = bytes.Scan([]byte(timeString))
+	maybePanic(err)
+	assertTime(t, bytes, "scanned RFC3339 []byte")
+
+	var datetime Time
+	err = datetime.Scan("2012-12-21 21:21:21")
+	maybePanic(err)
+	assertTime(t, datetime, "scanned DATETIME string")
+
+	var micro Time
+	err = micro.Scan([]byte("2012-12-21 21:21:21.123456"))
+	maybePanic(err)
+	if !micro.Valid || !micro.Time.Equal(timeValue.Add(123456*time.Microsecond)) {
+		t.Errorf("bad %s time: %v (valid: %t)\n", "scanned DATETIME []byte", micro.Time, micro.Valid)
+	}
+
+	var null Time
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullTime(t, null, "scanned null")
+
+	var invalid Time
+	err = invalid.Scan("hello world")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, invalid, "scanned invalid string")
+}
+
+func TestTimeScanZone(t *testing.T) {
+	for _, tc := range []struct {
+		in     interface{}
+		offset int
+	}{
+		{"2021-01-02T15:04:05-05:00", -5 * 60 * 60},
+		{[]byte("2021-01-02T15:04:05+05:30"), 5*60*60 + 30*60},
+		{"2021-01-02T15:04:05.123456+09:00", 9 * 60 * 60},
+	} {
+		var ti Time
+		err := ti.Scan(tc.in)
+		maybePanic(err)
+		if _, offset := ti.Time.Zone(); offset != tc.offset {
+			t.Errorf("Scan(%q) has offset %d, want %d", tc.in, offset, tc.offset)
+		}
+		// the wall clock reading is kept, not just the instant
+		if h, m, s := ti.Time.Clock(); h != 15 || m != 4 || s != 5 {
+			t.Errorf("Scan(%q) has clock %02d:%02d:%02d, want 15:04:05", tc.in, h, m, s)
+		}
+		v, err := ti.Value()
+		maybePanic(err)
+		if _, offset := v.(time.Time).Zone(); offset != tc.offset {
+			t.Errorf("Value() after Scan(%q) has offset %d, want %d", tc.in, offset, tc.offset)
+		}
+	}
+
+	for _, in := range []string{"2021-01-02T15:04:05Z", "2021-01-02 15:04:05"} {
+		var utc Time
+		err := utc.Scan(in)
+		maybePanic(err)
+		if utc.Time.Location() != time.UTC {
+			t.Errorf("Scan(%q) has location %v, want UTC", in, utc.Time.Location())
+		}
+	}
+}
+
+func TestTimeScanInfinity(t *testing.T) {
+	tests := []struct {
+		src  interface{}
+		want time.Time
+		json string
+	}{
+		{"infinity", PositiveInfinity, `"infinity"`},
+		{[]byte("infinity"), PositiveInfinity, `"infinity"`},
+		{"-infinity", NegativeInfinity, `"-infinity"`},
+		{[]byte("-infinity"), NegativeInfinity, `"-infinity"`},
+		{timeString, timeValue, string(timeJSON)},
+	}
+	for _, tc := range tests {
+		var ti Time
+		err := ti.Scan(tc.src)
+		maybePanic(err)
+		if !ti.Valid || !ti.Time.Equal(tc.want) {
+			t.Errorf("Scan(%q) = %v (valid: %t), want %v", tc.src, ti.Time, ti.Valid, tc.want)
+		}
+
+		data, err := json.Marshal(ti)
+		maybePanic(err)
+		assertJSONEquals(t, data, tc.json, "scanned infinity json marshal")
+
+		var back Time
+		err = json.Unmarshal(data, &back)
+		maybePanic(err)
+		if !back.Valid || !back.Time.Equal(tc.want) {
+			t.Errorf("UnmarshalJSON(%s) = %v (valid: %t), want %v", data, back.Time, back.Valid, tc.want)
+		}
+
+		text, err := ti.MarshalText()
+		maybePanic(err)
+		var fromText Time
+		err = fromText.UnmarshalText(text)
+		maybePanic(err)
+		if !fromText.Time.Equal(tc.want) {
+			t.Errorf("UnmarshalText(%s) = %v, want %v", text, fromText.Time, tc.want)
+		}
+	}
+
+	if !PositiveInfinity.After(time.Date(294276, time.December, 31, 23, 59, 59, 999999000, time.UTC)) {
+		t.Error("PositiveInfinity should be after the latest Postgres timestamp")
+	}
+	if !NegativeInfinity.Before(time.Date(-4713, time.November, 24, 0, 0, 0, 0, time.UTC)) {
+		t.Error("NegativeInfinity should be before the earliest Postgres timestamp")
+	}
+}
+
+func TestTimeValueInfinity(t *testing.T) {
+	for want, ti := range map[string]Time{
+		"infinity":  TimeFrom(PositiveInfinity),
+		"-infinity": TimeFrom(NegativeInfinity),
+	} {
+		v, err := ti.Value()
+		maybePanic(err)
+		if v != want {
+			t.Errorf("Value() = %#v, want %q", v, want)
+		}
+	}
+
+	v, err := TimeFrom(timeValue).Value()
+	maybePanic(err)
+	if v != timeValue {
+		t.Errorf("Value() = %#v, want %v", v, timeValue)
+	}
+
+	v, err = Time{}.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null Value() = %#v, want nil", v)
+	}
+}
+
+func TestTimeString(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	if ti.String() != timeString {
+		t.Errorf("bad String() output: %s\n", ti.String())
+	}
+
+	defer func(old string) { NullDisplay = old }(NullDisplay)
+	NullDisplay = "<nil>"
+	null := NewTime(timeValue, false)
+	if out := fmt.Sprint(null); out != "<nil>" {
+		t.Errorf("bad null fmt output: %s\n", out)
+	}
+}
+
+func TestTimeCompare(t *testing.T) {
+	early := TimeFrom(timeValue)
+	late := TimeFrom(timeValue.Add(time.Hour))
+	null := NewTime(timeValue, false)
+
+	if !late.After(early) || late.Before(early) {
+		t.Error("late should be after early")
+	}
+	if !early.Before(late) || early.After(late) {
+		t.Error("early should be before late")
+	}
+	if d, ok := late.Sub(early); !ok || d != time.Hour {
+		t.Errorf("bad Sub(): %v, %v ≠ %v, true\n", d, ok, time.Hour)
+	}
+
+	for _, pair := range [][2]Time{{early, null}, {null, early}, {null, null}} {
+		a, b := pair[0], pair[1]
+		if a.After(b) || a.Before(b) {
+			t.Errorf("After() and Before() should be false for %v and %v", a, b)
+		}
+		if d, ok := a.Sub(b); ok || d != 0 {
+			t.Errorf("bad Sub() for %v and %v: %v, %v ≠ 0, false\n", a, b, d, ok)
+		}
+	}
+
+	sameInstant := TimeFrom(timeValue.In(time.FixedZone("test", 3600)))
+	for _, tc := range []struct {
+		a, b       Time
+		want, last int
+	}{
+		{early, late, -1, -1},
+		{late, early, 1, 1},
+		{early, sameInstant, 0, 0},
+		{null, early, -1, 1},
+		{early, null, 1, -1},
+		{null, NewTime(time.Time{}, false), 0, 0},
+	} {
+		if got := tc.a.Compare(tc.b); got != tc.want {
+			t.Errorf("Compare(%v, %v) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+		NullsLast = true
+		if got := tc.a.Compare(tc.b); got != tc.last {
+			t.Errorf("Compare(%v, %v) with NullsLast = %d, want %d", tc.a, tc.b, got, tc.last)
+		}
+		NullsLast = false
+	}
+}
+
+func TestTimeIsPastFuture(t *testing.T) {
+	defer func(now func() time.Time) { Now = now }(Now)
+	Now = func() time.Time { return timeValue }
+
+	past := TimeFrom(timeValue.Add(-time.Second))
+	if !past.IsPast() || past.IsFuture() {
+		t.Error("a time before Now should be past")
+	}
+	future := TimeFrom(timeValue.Add(time.Second))
+	if future.IsPast() || !future.IsFuture() {
+		t.Error("a time after Now should be future")
+	}
+	now := TimeFrom(timeValue)
+	if now.IsPast() || now.IsFuture() {
+		t.Error("Now itself should be neither past nor future")
+	}
+	null := NewTime(timeValue.Add(-time.Second), false)
+	if null.IsPast() || null.IsFuture() {
+		t.Error("a null Time should be neither past nor future")
+	}
+}
+
+func TestTimeFormatString(t *testing.T) {
+	s := TimeFrom(timeValue).Format("2006-01-02")
+	if !s.Valid || s.String != "2012-12-21" {
+		t.Errorf("bad Format(): %#v", s)
+	}
+	assertNullStr(t, NewTime(timeValue, false).Format("2006-01-02"), "null Format()")
+}
+
+func TestTimeAdd(t *testing.T) {
+	ti := TimeFrom(timeValue.Add(-time.Hour))
+	assertTime(t, ti.Add(time.Hour), "Add()")
+	assertTime(t, TimeFrom(time.Date(2011, time.November, 20, 21, 21, 21, 0, time.UTC)).AddDate(1, 1, 1), "AddDate()")
+
+	null := NewTime(timeValue, false)
+	if null.Add(time.Hour) != null || null.AddDate(0, 0, 1) != null {
+		t.Error("Add() and AddDate() should return a null Time unchanged")
+	}
+}
+
+func TestTimeAddDateDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database not available:", err)
+	}
+	// Daylight saving time starts in New York on 2012-03-11
+	before := TimeFrom(time.Date(2012, time.March, 10, 12, 0, 0, 0, ny))
+	after := before.AddDate(0, 0, 1)
+	want := time.Date(2012, time.March, 11, 12, 0, 0, 0, ny)
+	if !after.Valid || !after.Time.Equal(want) {
+		t.Errorf("bad AddDate() across DST: %v ≠ %v\n", after.Time, want)
+	}
+	if d, _ := after.Sub(before); d != 23*time.Hour {
+		t.Errorf("bad AddDate() across DST: %v elapsed, want 23h", d)
+	}
+}
+
+func TestTimeTruncateIn(t *testing.T) {
+	ti := TimeFrom(timeValue.Add(42 * time.Second))
+	trunc := ti.Truncate(time.Minute)
+	if !trunc.Valid || trunc.Time != time.Date(2012, time.December, 21, 21, 22, 0, 0, time.UTC) {
+		t.Errorf("bad Truncate(): %v\n", trunc)
+	}
+
+	loc := time.FixedZone("test", -5*60*60)
+	in := TimeFrom(timeValue).In(loc)
+	if !in.Valid || in.Time.Location() != loc || !in.Time.Equal(timeValue) {
+		t.Errorf("bad In(): %v\n", in)
+	}
+
+	null := NewTime(timeValue, false)
+	if null.Truncate(time.Hour) != null {
+		t.Error("Truncate() should return a null Time unchanged")
+	}
+	if null.In(loc) != null {
+		t.Error("In() should return a null Time unchanged")
+	}
+}
+
+func TestTimeUTCLocal(t *testing.T) {
+	ti := TimeFrom(timeValue.In(time.FixedZone("test", -5*60*60)))
+	utc := ti.UTC()
+	if !utc.Valid || utc.Time.Location() != time.UTC || !utc.Time.Equal(timeValue) {
+		t.Errorf("bad UTC(): %v\n", utc)
+	}
+	local := ti.Local()
+	if !local.Valid || local.Time.Location() != time.Local || !local.Time.Equal(timeValue) {
+		t.Errorf("bad Local(): %v\n", local)
+	}
+
+	null := NewTime(timeValue, false)
+	if null.UTC() != null || null.Local() != null {
+		t.Error("UTC() and Local() should return a null Time unchanged")
+	}
+}
+
+func TestTimeIsZero(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	if ti.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := TimeFromPtr(nil)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := NewTime(time.Time{}, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for the zero instant")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestTimeSetValid(t *testing.T) {
+	var ti Time
+	assertNullTime(t, ti, "SetValid()")
+	ti.SetValid(timeValue)
+	assertTime(t, ti, "SetValid()")
+}
+
+func TestTimeSet(t *testing.T) {
+	var ti Time
+	ti.Set(timeValue, true)
+	assertTime(t, ti, "Set(x, true)")
+
+	ti.Set(timeValue, false)
+	assertNullTime(t, ti, "Set(x, false)")
+	if !ti.Time.Equal(timeValue) {
+		t.Errorf("Set(x, false) should keep the value, got %v", ti.Time)
+	}
+}
+
+func TestTimeSetNull(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	ti.SetNull()
+	assertNullTime(t, ti, "SetNull()")
+	if !ti.Time.IsZero() {
+		t.Errorf("SetNull() should reset the value, got %v", ti.Time)
+	}
+}
+
+func TestTimeSetValidIf(t *testing.T) {
+	var ti Time
+	ti.SetValidIf(timeValue, true)
+	assertTime(t, ti, "SetValidIf(x, true)")
+
+	ti.SetValidIf(timeValue, false)
+	assertNullTime(t, ti, "SetValidIf(x, false)")
+	if !ti.Time.IsZero() {
+		t.Errorf("SetValidIf(x, false) should reset the value, got %v", ti.Time)
+	}
+}
+
+func TestTimePointer(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	ptr := ti.Ptr()
+	if *ptr != timeValue {
+		t.Errorf("bad %s time: %#v ≠ %v\n", "pointer", ptr, timeValue)
+	}
+
+	var nt Time
+	ptr = nt.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s time: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func assertTime(t *testing.T, ti Time, from string) {
+	if !ti.Time.Equal(timeValue) {
+		t.Errorf("bad %v time: %v ≠ %v\n", from, ti.Time, timeValue)
+	}
+	if !ti.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullTime(t *testing.T, ti Time, from string) {
+	if ti.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertTimeEqualIsTrue(t *testing.T, a, b Time) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Time{%v, Valid:%t} and Time{%v, Valid:%t} should return true", a.Time, a.Valid, b.Time, b.Valid)
+	}
+}
+
+func assertTimeEqualIsFalse(t *testing.T, a, b Time) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Time{%v, Valid:%t} and Time{%v, Valid:%t} should return false", a.Time, a.Valid, b.Time, b.Valid)
+	}
+}
+
+func TestMarshalTimeJSONFastPath(t *testing.T) {
+	defer SetTimeFormat(TimeFormat)
+	times := []time.Time{
+		timeValue,
+		time.Date(2012, time.December, 21, 21, 21, 21, 123456789, time.FixedZone("test", -5*60*60)),
+		{},
+	}
+	layouts := []string{time.RFC3339Nano, time.RFC3339, time.RFC1123, "2006-01-02 15:04:05", `<"Mon"> & \ 2006`, "Jan 2 2006 MST"}
+	for _, layout := range layouts {
+		SetTimeFormat(layout)
+		for _, tv := range times {
+			data, err := TimeFrom(tv).MarshalJSON()
+			maybePanic(err)
+			want, err := json.Marshal(tv.Format(layout))
+			maybePanic(err)
+			assertJSONEquals(t, data, string(want), "fast path "+layout)
+		}
+	}
+}
+
+func TestMarshalTimeFixedPrecision(t *testing.T) {
+	defer SetTimeFormat(TimeFormat)
+	whole := time.Date(2012, time.December, 21, 21, 21, 21, 0, time.UTC)
+	nanos := time.Date(2012, time.December, 21, 21, 21, 21, 123456789, time.UTC)
+	tests := []struct {
+		layout       string
+		precision    time.Duration
+		whole, nanos string
+	}{
+		{RFC3339Milli, time.Millisecond, `"2012-12-21T21:21:21.000Z"`, `"2012-12-21T21:21:21.123Z"`},
+		{RFC3339Micro, time.Microsecond, `"2012-12-21T21:21:21.000000Z"`, `"2012-12-21T21:21:21.123456Z"`},
+	}
+	for _, tc := range tests {
+		SetTimeFormat(tc.layout)
+		data, err := json.Marshal(TimeFrom(whole))
+		maybePanic(err)
+		assertJSONEquals(t, data, tc.whole, "whole second "+tc.layout)
+
+		data, err = json.Marshal(TimeFrom(nanos))
+		maybePanic(err)
+		assertJSONEquals(t, data, tc.nanos, "nanoseconds "+tc.layout)
+
+		var back Time
+		err = json.Unmarshal(data, &back)
+		maybePanic(err)
+		if want := nanos.Truncate(tc.precision); !back.Valid || !back.Time.Equal(want) {
+			t.Errorf("bad %s round trip: %v ≠ %v", tc.layout, back.Time, want)
+		}
+	}
+}
+
+func BenchmarkTimeMarshalJSON(b *testing.B) {
+	b.ReportAllocs()
+	ti := TimeFrom(timeValue)
+	for i := 0; i < b.N; i++ {
+		if _, err := ti.MarshalJSON(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTimeUnmarshalJSON(b *testing.B) {
+	inputs := []struct {
+		name string
+		data []byte
+	}{
+		{"string", timeJSON},
+		{"number", []byte(`1356124881`)},
+		{"object", nullTimeJSON},
+		{"null", nullJSON},
+	}
+	for _, in := range inputs {
+		b.Run(in.name, func(b *testing.B) {
+			b.ReportAllocs()
+			var ti Time
+			for i := 0; i < b.N; i++ {
+				if err := ti.UnmarshalJSON(in.data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
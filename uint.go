@@ -0,0 +1,204 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Uint is a nullable uint.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Negative input, and input that does not fit in a uint, is rejected.
+type Uint struct {
+	Uint  uint
+	Valid bool
+}
+
+// NewUint creates a new Uint
+func NewUint(u uint, valid bool) Uint {
+	return Uint{
+		Uint:  u,
+		Valid: valid,
+	}
+}
+
+// UintFrom creates a new Uint that will always be valid.
+func UintFrom(u uint) Uint {
+	return NewUint(u, true)
+}
+
+// UintFromPtr creates a new Uint that be null if u is nil.
+func UintFromPtr(u *uint) Uint {
+	if u == nil {
+		return NewUint(0, false)
+	}
+	return NewUint(*u, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will not be considered a null Uint.
+// It will return an error if the number is negative or does not fit in a uint.
+func (u *Uint) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Uint")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch v.(type) {
+	case float64:
+		var n uint64
+		n, err = parseSizedUint(string(data), strconv.IntSize, "null.Uint")
+		u.Uint = uint(n)
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Uint", reflect.TypeOf(v).Name())
+	}
+	u.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not an unsigned integer that fits in a uint.
+func (u *Uint) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		u.Valid = false
+		return nil
+	}
+	n, err := parseSizedUint(str, strconv.IntSize, "null.Uint")
+	u.Uint, u.Valid = uint(n), err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint is null.
+func (u Uint) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Uint is null.
+func (u Uint) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint), 10)), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports int64, []byte, and string values,
+// and will return an error if the value is negative or does not fit in a uint.
+func (u *Uint) Scan(value interface{}) error {
+	var n uint64
+	var err error
+	switch x := value.(type) {
+	case int64:
+		if x < 0 || uint64(x) > math.MaxUint {
+			err = sizedUintRangeError(strconv.FormatInt(x, 10), strconv.IntSize, "null.Uint")
+		}
+		n = uint64(x)
+	case []byte:
+		n, err = parseSizedUint(string(x), strconv.IntSize, "null.Uint")
+	case string:
+		n, err = parseSizedUint(x, strconv.IntSize, "null.Uint")
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.Uint: %v", value, value)
+	}
+	u.Uint, u.Valid = uint(n), err == nil
+	return err
+}
+
+// Value implements driver.Valuer.
+// It returns an int64, or a decimal string for values too large for an int64,
+// or nil if this Uint is null.
+func (u Uint) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	if uint64(u.Uint) > math.MaxInt64 {
+		return strconv.FormatUint(uint64(u.Uint), 10), nil
+	}
+	return int64(u.Uint), nil
+}
+
+// SetValid changes this Uint's value and also sets it to be non-null.
+func (u *Uint) SetValid(n uint) {
+	u.Uint = n
+	u.Valid = true
+}
+
+// SetNull sets this Uint to null, resetting its value to zero.
+func (u *Uint) SetNull() {
+	u.Uint = 0
+	u.Valid = false
+}
+
+// Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
+func (u Uint) Ptr() *uint {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u Uint) ValueOrZero() uint {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint
+}
+
+// IsNull returns true if this Uint is null.
+func (u Uint) IsNull() bool {
+	return !u.Valid
+}
+
+// Null is the same as IsNull.
+func (u Uint) Null() bool {
+	return !u.Valid
+}
+
+// IsValid returns true if this Uint is not null.
+func (u Uint) IsValid() bool {
+	return u.Valid
+}
+
+// IsZero returns true for null Uints and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Uint from a valid zero one.
+func (u Uint) IsZero() bool {
+	return !u.Valid || u.Uint == 0
+}
+
+// parseSizedUint parses s as a base 10 unsigned integer that must fit in the given number of bits.
+// It is used by the unsigned integer types, and negative input is reported as out of range.
+func parseSizedUint(s string, bits int, typ string) (uint64, error) {
+	if len(s) > 0 && s[0] == '-' {
+		if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return 0, sizedUintRangeError(s, bits, typ)
+		}
+	}
+	n, err := strconv.ParseUint(s, 10, bits)
+	if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+		return 0, sizedUintRangeError(s, bits, typ)
+	}
+	return n, err
+}
+
+// sizedUintRangeError returns the error for s not fitting in an unsigned integer type.
+func sizedUintRangeError(s string, bits int, typ string) error {
+	max := uint64(1)<<uint(bits-1)<<1 - 1
+	return fmt.Errorf("null: %s overflows %s, which must be between 0 and %d", s, typ, max)
+}
@@ -0,0 +1,179 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Uint16 is a nullable uint16.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Negative input, and input that does not fit in a uint16, is rejected.
+type Uint16 struct {
+	Uint16 uint16
+	Valid  bool
+}
+
+// NewUint16 creates a new Uint16
+func NewUint16(u uint16, valid bool) Uint16 {
+	return Uint16{
+		Uint16: u,
+		Valid:  valid,
+	}
+}
+
+// Uint16From creates a new Uint16 that will always be valid.
+func Uint16From(u uint16) Uint16 {
+	return NewUint16(u, true)
+}
+
+// Uint16FromPtr creates a new Uint16 that be null if u is nil.
+func Uint16FromPtr(u *uint16) Uint16 {
+	if u == nil {
+		return NewUint16(0, false)
+	}
+	return NewUint16(*u, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will not be considered a null Uint16.
+// It will return an error if the number is negative or does not fit in a uint16.
+func (u *Uint16) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Uint16")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch v.(type) {
+	case float64:
+		var n uint64
+		n, err = parseSizedUint(string(data), 16, "null.Uint16")
+		u.Uint16 = uint16(n)
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Uint16", reflect.TypeOf(v).Name())
+	}
+	u.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint16 if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not an unsigned integer that fits in a uint16.
+func (u *Uint16) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		u.Valid = false
+		return nil
+	}
+	n, err := parseSizedUint(str, 16, "null.Uint16")
+	u.Uint16, u.Valid = uint16(n), err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint16 is null.
+func (u Uint16) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint16), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Uint16 is null.
+func (u Uint16) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint16), 10)), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports int64, []byte, and string values,
+// and will return an error if the value is negative or does not fit in a uint16.
+func (u *Uint16) Scan(value interface{}) error {
+	var n uint64
+	var err error
+	switch x := value.(type) {
+	case int64:
+		if x < 0 || x > math.MaxUint16 {
+			err = sizedUintRangeError(strconv.FormatInt(x, 10), 16, "null.Uint16")
+		}
+		n = uint64(x)
+	case []byte:
+		n, err = parseSizedUint(string(x), 16, "null.Uint16")
+	case string:
+		n, err = parseSizedUint(x, 16, "null.Uint16")
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.Uint16: %v", value, value)
+	}
+	u.Uint16, u.Valid = uint16(n), err == nil
+	return err
+}
+
+// Value implements driver.Valuer.
+// It returns an int64, or nil if this Uint16 is null.
+func (u Uint16) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return int64(u.Uint16), nil
+}
+
+// SetValid changes this Uint16's value and also sets it to be non-null.
+func (u *Uint16) SetValid(n uint16) {
+	u.Uint16 = n
+	u.Valid = true
+}
+
+// SetNull sets this Uint16 to null, resetting its value to zero.
+func (u *Uint16) SetNull() {
+	u.Uint16 = 0
+	u.Valid = false
+}
+
+// Ptr returns a pointer to this Uint16's value, or a nil pointer if this Uint16 is null.
+func (u Uint16) Ptr() *uint16 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint16
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u Uint16) ValueOrZero() uint16 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint16
+}
+
+// IsNull returns true if this Uint16 is null.
+func (u Uint16) IsNull() bool {
+	return !u.Valid
+}
+
+// Null is the same as IsNull.
+func (u Uint16) Null() bool {
+	return !u.Valid
+}
+
+// IsValid returns true if this Uint16 is not null.
+func (u Uint16) IsValid() bool {
+	return u.Valid
+}
+
+// IsZero returns true for null Uint16s and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Uint16 from a valid zero one.
+func (u Uint16) IsZero() bool {
+	return !u.Valid || u.Uint16 == 0
+}
@@ -0,0 +1,198 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestUint16From(t *testing.T) {
+	u := Uint16From(123)
+	assertUint16(t, u, "Uint16From()")
+
+	zero := Uint16From(0)
+	if !zero.Valid {
+		t.Error("Uint16From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestUint16FromPtr(t *testing.T) {
+	n := uint16(123)
+	u := Uint16FromPtr(&n)
+	assertUint16(t, u, "Uint16FromPtr()")
+
+	null := Uint16FromPtr(nil)
+	assertNullUint16(t, null, "Uint16FromPtr(nil)")
+}
+
+func TestUnmarshalUint16(t *testing.T) {
+	var u Uint16
+	err := json.Unmarshal([]byte(`123`), &u)
+	maybePanic(err)
+	assertUint16(t, u, "uint16 json")
+
+	var null Uint16
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUint16(t, null, "null json")
+
+	var badType Uint16
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint16(t, badType, "wrong type json")
+}
+
+func TestUnmarshalUint16Bounds(t *testing.T) {
+	var u Uint16
+	err := json.Unmarshal([]byte(strconv.FormatUint(math.MaxUint16, 10)), &u)
+	maybePanic(err)
+	if !u.Valid || u.Uint16 != math.MaxUint16 {
+		t.Errorf("bad max uint16 json: %d (valid: %t)\n", u.Uint16, u.Valid)
+	}
+
+	for _, s := range []string{"-1", "65536"} {
+		err = json.Unmarshal([]byte(s), &u)
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullUint16(t, u, "out of range json")
+
+		err = u.UnmarshalText([]byte(s))
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullUint16(t, u, "out of range text")
+	}
+}
+
+func TestMarshalUint16(t *testing.T) {
+	u := Uint16From(math.MaxUint16)
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, strconv.FormatUint(math.MaxUint16, 10), "max json marshal")
+
+	data, err = u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, strconv.FormatUint(math.MaxUint16, 10), "max text marshal")
+
+	null := NewUint16(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestUint16Pointer(t *testing.T) {
+	u := Uint16From(123)
+	ptr := u.Ptr()
+	if *ptr != 123 {
+		t.Errorf("bad %s uint16: %#v ≠ %d\n", "pointer", ptr, 123)
+	}
+
+	null := NewUint16(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uint16: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUint16ValueOrZero(t *testing.T) {
+	valid := Uint16From(123)
+	if valid.ValueOrZero() != 123 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewUint16(123, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+	if invalid.IsZero() != true || valid.IsZero() != false {
+		t.Error("unexpected IsZero")
+	}
+	if !invalid.IsZero() || invalid.IsValid() {
+		t.Error("unexpected IsZero or IsValid for a null Uint16")
+	}
+	zero := Uint16From(0)
+	if !zero.IsZero() || !zero.IsValid() {
+		t.Error("unexpected IsZero or IsValid for a valid zero Uint16")
+	}
+}
+
+func TestUint16SetValid(t *testing.T) {
+	change := NewUint16(0, false)
+	assertNullUint16(t, change, "SetValid()")
+	change.SetValid(123)
+	assertUint16(t, change, "SetValid()")
+}
+
+func TestUint16Scan(t *testing.T) {
+	var u Uint16
+	err := u.Scan(int64(123))
+	maybePanic(err)
+	assertUint16(t, u, "scanned int64")
+
+	var b Uint16
+	err = b.Scan([]byte("123"))
+	maybePanic(err)
+	assertUint16(t, b, "scanned []byte")
+
+	var s Uint16
+	err = s.Scan("123")
+	maybePanic(err)
+	assertUint16(t, s, "scanned string")
+
+	var max Uint16
+	err = max.Scan(strconv.FormatUint(math.MaxUint16, 10))
+	maybePanic(err)
+	if max.Uint16 != math.MaxUint16 {
+		t.Errorf("bad scanned max uint16: %d\n", max.Uint16)
+	}
+	v, err := max.Value()
+	maybePanic(err)
+	var back Uint16
+	err = back.Scan(v)
+	maybePanic(err)
+	if back != max {
+		t.Errorf("bad max uint16 value round trip: %v ≠ %v\n", back, max)
+	}
+
+	var null Uint16
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUint16(t, null, "scanned null")
+
+	var negative Uint16
+	err = negative.Scan(int64(-1))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint16(t, negative, "scanned negative")
+
+	var big Uint16
+	err = big.Scan(int64(math.MaxUint16) + 1)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint16(t, big, "scanned overflow")
+}
+
+func assertUint16(t *testing.T, u Uint16, from string) {
+	if u.Uint16 != 123 {
+		t.Errorf("bad %s uint16: %d ≠ %d\n", from, u.Uint16, 123)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint16(t *testing.T, u Uint16, from string) {
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,179 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Uint32 is a nullable uint32.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Negative input, and input that does not fit in a uint32, is rejected.
+type Uint32 struct {
+	Uint32 uint32
+	Valid  bool
+}
+
+// NewUint32 creates a new Uint32
+func NewUint32(u uint32, valid bool) Uint32 {
+	return Uint32{
+		Uint32: u,
+		Valid:  valid,
+	}
+}
+
+// Uint32From creates a new Uint32 that will always be valid.
+func Uint32From(u uint32) Uint32 {
+	return NewUint32(u, true)
+}
+
+// Uint32FromPtr creates a new Uint32 that be null if u is nil.
+func Uint32FromPtr(u *uint32) Uint32 {
+	if u == nil {
+		return NewUint32(0, false)
+	}
+	return NewUint32(*u, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will not be considered a null Uint32.
+// It will return an error if the number is negative or does not fit in a uint32.
+func (u *Uint32) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Uint32")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch v.(type) {
+	case float64:
+		var n uint64
+		n, err = parseSizedUint(string(data), 32, "null.Uint32")
+		u.Uint32 = uint32(n)
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Uint32", reflect.TypeOf(v).Name())
+	}
+	u.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint32 if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not an unsigned integer that fits in a uint32.
+func (u *Uint32) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		u.Valid = false
+		return nil
+	}
+	n, err := parseSizedUint(str, 32, "null.Uint32")
+	u.Uint32, u.Valid = uint32(n), err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint32 is null.
+func (u Uint32) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint32), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Uint32 is null.
+func (u Uint32) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint32), 10)), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports int64, []byte, and string values,
+// and will return an error if the value is negative or does not fit in a uint32.
+func (u *Uint32) Scan(value interface{}) error {
+	var n uint64
+	var err error
+	switch x := value.(type) {
+	case int64:
+		if x < 0 || x > math.MaxUint32 {
+			err = sizedUintRangeError(strconv.FormatInt(x, 10), 32, "null.Uint32")
+		}
+		n = uint64(x)
+	case []byte:
+		n, err = parseSizedUint(string(x), 32, "null.Uint32")
+	case string:
+		n, err = parseSizedUint(x, 32, "null.Uint32")
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.Uint32: %v", value, value)
+	}
+	u.Uint32, u.Valid = uint32(n), err == nil
+	return err
+}
+
+// Value implements driver.Valuer.
+// It returns an int64, or nil if this Uint32 is null.
+func (u Uint32) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return int64(u.Uint32), nil
+}
+
+// SetValid changes this Uint32's value and also sets it to be non-null.
+func (u *Uint32) SetValid(n uint32) {
+	u.Uint32 = n
+	u.Valid = true
+}
+
+// SetNull sets this Uint32 to null, resetting its value to zero.
+func (u *Uint32) SetNull() {
+	u.Uint32 = 0
+	u.Valid = false
+}
+
+// Ptr returns a pointer to this Uint32's value, or a nil pointer if this Uint32 is null.
+func (u Uint32) Ptr() *uint32 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint32
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u Uint32) ValueOrZero() uint32 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint32
+}
+
+// IsNull returns true if this Uint32 is null.
+func (u Uint32) IsNull() bool {
+	return !u.Valid
+}
+
+// Null is the same as IsNull.
+func (u Uint32) Null() bool {
+	return !u.Valid
+}
+
+// IsValid returns true if this Uint32 is not null.
+func (u Uint32) IsValid() bool {
+	return u.Valid
+}
+
+// IsZero returns true for null Uint32s and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Uint32 from a valid zero one.
+func (u Uint32) IsZero() bool {
+	return !u.Valid || u.Uint32 == 0
+}
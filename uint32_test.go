@@ -0,0 +1,198 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestUint32From(t *testing.T) {
+	u := Uint32From(123)
+	assertUint32(t, u, "Uint32From()")
+
+	zero := Uint32From(0)
+	if !zero.Valid {
+		t.Error("Uint32From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestUint32FromPtr(t *testing.T) {
+	n := uint32(123)
+	u := Uint32FromPtr(&n)
+	assertUint32(t, u, "Uint32FromPtr()")
+
+	null := Uint32FromPtr(nil)
+	assertNullUint32(t, null, "Uint32FromPtr(nil)")
+}
+
+func TestUnmarshalUint32(t *testing.T) {
+	var u Uint32
+	err := json.Unmarshal([]byte(`123`), &u)
+	maybePanic(err)
+	assertUint32(t, u, "uint32 json")
+
+	var null Uint32
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUint32(t, null, "null json")
+
+	var badType Uint32
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint32(t, badType, "wrong type json")
+}
+
+func TestUnmarshalUint32Bounds(t *testing.T) {
+	var u Uint32
+	err := json.Unmarshal([]byte(strconv.FormatUint(math.MaxUint32, 10)), &u)
+	maybePanic(err)
+	if !u.Valid || u.Uint32 != math.MaxUint32 {
+		t.Errorf("bad max uint32 json: %d (valid: %t)\n", u.Uint32, u.Valid)
+	}
+
+	for _, s := range []string{"-1", "4294967296"} {
+		err = json.Unmarshal([]byte(s), &u)
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullUint32(t, u, "out of range json")
+
+		err = u.UnmarshalText([]byte(s))
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullUint32(t, u, "out of range text")
+	}
+}
+
+func TestMarshalUint32(t *testing.T) {
+	u := Uint32From(math.MaxUint32)
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, strconv.FormatUint(math.MaxUint32, 10), "max json marshal")
+
+	data, err = u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, strconv.FormatUint(math.MaxUint32, 10), "max text marshal")
+
+	null := NewUint32(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestUint32Pointer(t *testing.T) {
+	u := Uint32From(123)
+	ptr := u.Ptr()
+	if *ptr != 123 {
+		t.Errorf("bad %s uint32: %#v ≠ %d\n", "pointer", ptr, 123)
+	}
+
+	null := NewUint32(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uint32: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUint32ValueOrZero(t *testing.T) {
+	valid := Uint32From(123)
+	if valid.ValueOrZero() != 123 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewUint32(123, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+	if invalid.IsZero() != true || valid.IsZero() != false {
+		t.Error("unexpected IsZero")
+	}
+	if !invalid.IsZero() || invalid.IsValid() {
+		t.Error("unexpected IsZero or IsValid for a null Uint32")
+	}
+	zero := Uint32From(0)
+	if !zero.IsZero() || !zero.IsValid() {
+		t.Error("unexpected IsZero or IsValid for a valid zero Uint32")
+	}
+}
+
+func TestUint32SetValid(t *testing.T) {
+	change := NewUint32(0, false)
+	assertNullUint32(t, change, "SetValid()")
+	change.SetValid(123)
+	assertUint32(t, change, "SetValid()")
+}
+
+func TestUint32Scan(t *testing.T) {
+	var u Uint32
+	err := u.Scan(int64(123))
+	maybePanic(err)
+	assertUint32(t, u, "scanned int64")
+
+	var b Uint32
+	err = b.Scan([]byte("123"))
+	maybePanic(err)
+	assertUint32(t, b, "scanned []byte")
+
+	var s Uint32
+	err = s.Scan("123")
+	maybePanic(err)
+	assertUint32(t, s, "scanned string")
+
+	var max Uint32
+	err = max.Scan(strconv.FormatUint(math.MaxUint32, 10))
+	maybePanic(err)
+	if max.Uint32 != math.MaxUint32 {
+		t.Errorf("bad scanned max uint32: %d\n", max.Uint32)
+	}
+	v, err := max.Value()
+	maybePanic(err)
+	var back Uint32
+	err = back.Scan(v)
+	maybePanic(err)
+	if back != max {
+		t.Errorf("bad max uint32 value round trip: %v ≠ %v\n", back, max)
+	}
+
+	var null Uint32
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUint32(t, null, "scanned null")
+
+	var negative Uint32
+	err = negative.Scan(int64(-1))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint32(t, negative, "scanned negative")
+
+	var big Uint32
+	err = big.Scan(int64(math.MaxUint32) + 1)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint32(t, big, "scanned overflow")
+}
+
+func assertUint32(t *testing.T, u Uint32, from string) {
+	if u.Uint32 != 123 {
+		t.Errorf("bad %s uint32: %d ≠ %d\n", from, u.Uint32, 123)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint32(t *testing.T, u Uint32, from string) {
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
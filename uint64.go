@@ -0,0 +1,182 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Uint64 is a nullable uint64.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Negative input, and input that does not fit in a uint64, is rejected.
+type Uint64 struct {
+	Uint64 uint64
+	Valid  bool
+}
+
+// NewUint64 creates a new Uint64
+func NewUint64(u uint64, valid bool) Uint64 {
+	return Uint64{
+		Uint64: u,
+		Valid:  valid,
+	}
+}
+
+// Uint64From creates a new Uint64 that will always be valid.
+func Uint64From(u uint64) Uint64 {
+	return NewUint64(u, true)
+}
+
+// Uint64FromPtr creates a new Uint64 that be null if u is nil.
+func Uint64FromPtr(u *uint64) Uint64 {
+	if u == nil {
+		return NewUint64(0, false)
+	}
+	return NewUint64(*u, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will not be considered a null Uint64.
+// It will return an error if the number is negative or does not fit in a uint64.
+func (u *Uint64) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Uint64")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch v.(type) {
+	case float64:
+		u.Uint64, err = parseSizedUint(string(data), 64, "null.Uint64")
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Uint64", reflect.TypeOf(v).Name())
+	}
+	u.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint64 if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not an unsigned integer that fits in a uint64.
+func (u *Uint64) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		u.Valid = false
+		return nil
+	}
+	n, err := parseSizedUint(str, 64, "null.Uint64")
+	u.Uint64, u.Valid = n, err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint64 is null.
+func (u Uint64) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(u.Uint64, 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Uint64 is null.
+func (u Uint64) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatUint(u.Uint64, 10)), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports int64, []byte, and string values,
+// and will return an error if the value is negative or does not fit in a uint64.
+func (u *Uint64) Scan(value interface{}) error {
+	var n uint64
+	var err error
+	switch x := value.(type) {
+	case int64:
+		if x < 0 {
+			err = sizedUintRangeError(strconv.FormatInt(x, 10), 64, "null.Uint64")
+		} else {
+			n = uint64(x)
+		}
+	case []byte:
+		n, err = parseSizedUint(string(x), 64, "null.Uint64")
+	case string:
+		n, err = parseSizedUint(x, 64, "null.Uint64")
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.Uint64: %v", value, value)
+	}
+	u.Uint64, u.Valid = n, err == nil
+	return err
+}
+
+// Value implements driver.Valuer.
+// It returns an int64, or a decimal string for values too large for an int64,
+// or nil if this Uint64 is null.
+func (u Uint64) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	if u.Uint64 > math.MaxInt64 {
+		return strconv.FormatUint(u.Uint64, 10), nil
+	}
+	return int64(u.Uint64), nil
+}
+
+// SetValid changes this Uint64's value and also sets it to be non-null.
+func (u *Uint64) SetValid(n uint64) {
+	u.Uint64 = n
+	u.Valid = true
+}
+
+// SetNull sets this Uint64 to null, resetting its value to zero.
+func (u *Uint64) SetNull() {
+	u.Uint64 = 0
+	u.Valid = false
+}
+
+// Ptr returns a pointer to this Uint64's value, or a nil pointer if this Uint64 is null.
+func (u Uint64) Ptr() *uint64 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint64
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u Uint64) ValueOrZero() uint64 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint64
+}
+
+// IsNull returns true if this Uint64 is null.
+func (u Uint64) IsNull() bool {
+	return !u.Valid
+}
+
+// Null is the same as IsNull.
+func (u Uint64) Null() bool {
+	return !u.Valid
+}
+
+// IsValid returns true if this Uint64 is not null.
+func (u Uint64) IsValid() bool {
+	return u.Valid
+}
+
+// IsZero returns true for null Uint64s and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Uint64 from a valid zero one.
+func (u Uint64) IsZero() bool {
+	return !u.Valid || u.Uint64 == 0
+}
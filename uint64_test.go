@@ -0,0 +1,194 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestUint64From(t *testing.T) {
+	u := Uint64From(123)
+	assertUint64(t, u, "Uint64From()")
+
+	zero := Uint64From(0)
+	if !zero.Valid {
+		t.Error("Uint64From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestUint64FromPtr(t *testing.T) {
+	n := uint64(123)
+	u := Uint64FromPtr(&n)
+	assertUint64(t, u, "Uint64FromPtr()")
+
+	null := Uint64FromPtr(nil)
+	assertNullUint64(t, null, "Uint64FromPtr(nil)")
+}
+
+func TestUnmarshalUint64(t *testing.T) {
+	var u Uint64
+	err := json.Unmarshal([]byte(`123`), &u)
+	maybePanic(err)
+	assertUint64(t, u, "uint64 json")
+
+	var null Uint64
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUint64(t, null, "null json")
+
+	var badType Uint64
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint64(t, badType, "wrong type json")
+}
+
+func TestUnmarshalUint64Bounds(t *testing.T) {
+	var u Uint64
+	err := json.Unmarshal([]byte(strconv.FormatUint(math.MaxUint64, 10)), &u)
+	maybePanic(err)
+	if !u.Valid || u.Uint64 != math.MaxUint64 {
+		t.Errorf("bad max uint64 json: %d (valid: %t)\n", u.Uint64, u.Valid)
+	}
+
+	for _, s := range []string{"-1", "18446744073709551616"} {
+		err = json.Unmarshal([]byte(s), &u)
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullUint64(t, u, "out of range json")
+
+		err = u.UnmarshalText([]byte(s))
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullUint64(t, u, "out of range text")
+	}
+}
+
+func TestMarshalUint64(t *testing.T) {
+	u := Uint64From(math.MaxUint64)
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, strconv.FormatUint(math.MaxUint64, 10), "max json marshal")
+
+	data, err = u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, strconv.FormatUint(math.MaxUint64, 10), "max text marshal")
+
+	null := NewUint64(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestUint64Pointer(t *testing.T) {
+	u := Uint64From(123)
+	ptr := u.Ptr()
+	if *ptr != 123 {
+		t.Errorf("bad %s uint64: %#v ≠ %d\n", "pointer", ptr, 123)
+	}
+
+	null := NewUint64(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uint64: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUint64ValueOrZero(t *testing.T) {
+	valid := Uint64From(123)
+	if valid.ValueOrZero() != 123 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewUint64(123, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+	if invalid.IsZero() != true || valid.IsZero() != false {
+		t.Error("unexpected IsZero")
+	}
+	if !invalid.IsZero() || invalid.IsValid() {
+		t.Error("unexpected IsZero or IsValid for a null Uint64")
+	}
+	zero := Uint64From(0)
+	if !zero.IsZero() || !zero.IsValid() {
+		t.Error("unexpected IsZero or IsValid for a valid zero Uint64")
+	}
+}
+
+func TestUint64SetValid(t *testing.T) {
+	change := NewUint64(0, false)
+	assertNullUint64(t, change, "SetValid()")
+	change.SetValid(123)
+	assertUint64(t, change, "SetValid()")
+}
+
+func TestUint64Scan(t *testing.T) {
+	var u Uint64
+	err := u.Scan(int64(123))
+	maybePanic(err)
+	assertUint64(t, u, "scanned int64")
+
+	var b Uint64
+	err = b.Scan([]byte("123"))
+	maybePanic(err)
+	assertUint64(t, b, "scanned []byte")
+
+	var s Uint64
+	err = s.Scan("123")
+	maybePanic(err)
+	assertUint64(t, s, "scanned string")
+
+	var max Uint64
+	err = max.Scan(strconv.FormatUint(math.MaxUint64, 10))
+	maybePanic(err)
+	if max.Uint64 != math.MaxUint64 {
+		t.Errorf("bad scanned max uint64: %d\n", max.Uint64)
+	}
+	v, err := max.Value()
+	maybePanic(err)
+	var back Uint64
+	err = back.Scan(v)
+	maybePanic(err)
+	if back != max {
+		t.Errorf("bad max uint64 value round trip: %v ≠ %v\n", back, max)
+	}
+
+	var null Uint64
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUint64(t, null, "scanned null")
+
+	var negative Uint64
+	err = negative.Scan(int64(-1))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint64(t, negative, "scanned negative")
+	if negative.Uint64 != 0 {
+		t.Errorf("scanned negative should leave the value at 0, got %d", negative.Uint64)
+	}
+}
+
+func assertUint64(t *testing.T, u Uint64, from string) {
+	if u.Uint64 != 123 {
+		t.Errorf("bad %s uint64: %d ≠ %d\n", from, u.Uint64, 123)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint64(t *testing.T, u Uint64, from string) {
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,179 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Uint8 is a nullable uint8.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Negative input, and input that does not fit in a uint8, is rejected.
+type Uint8 struct {
+	Uint8 uint8
+	Valid bool
+}
+
+// NewUint8 creates a new Uint8
+func NewUint8(u uint8, valid bool) Uint8 {
+	return Uint8{
+		Uint8: u,
+		Valid: valid,
+	}
+}
+
+// Uint8From creates a new Uint8 that will always be valid.
+func Uint8From(u uint8) Uint8 {
+	return NewUint8(u, true)
+}
+
+// Uint8FromPtr creates a new Uint8 that be null if u is nil.
+func Uint8FromPtr(u *uint8) Uint8 {
+	if u == nil {
+		return NewUint8(0, false)
+	}
+	return NewUint8(*u, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will not be considered a null Uint8.
+// It will return an error if the number is negative or does not fit in a uint8.
+func (u *Uint8) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.Uint8")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch v.(type) {
+	case float64:
+		var n uint64
+		n, err = parseSizedUint(string(data), 8, "null.Uint8")
+		u.Uint8 = uint8(n)
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Uint8", reflect.TypeOf(v).Name())
+	}
+	u.Valid = err == nil
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint8 if the input is blank or "null", or matches NullText.
+// It will return an error if the input is not an unsigned integer that fits in a uint8.
+func (u *Uint8) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" || isNullText(text) {
+		u.Valid = false
+		return nil
+	}
+	n, err := parseSizedUint(str, 8, "null.Uint8")
+	u.Uint8, u.Valid = uint8(n), err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint8 is null.
+func (u Uint8) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint8), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this Uint8 is null.
+func (u Uint8) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint8), 10)), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports int64, []byte, and string values,
+// and will return an error if the value is negative or does not fit in a uint8.
+func (u *Uint8) Scan(value interface{}) error {
+	var n uint64
+	var err error
+	switch x := value.(type) {
+	case int64:
+		if x < 0 || x > math.MaxUint8 {
+			err = sizedUintRangeError(strconv.FormatInt(x, 10), 8, "null.Uint8")
+		}
+		n = uint64(x)
+	case []byte:
+		n, err = parseSizedUint(string(x), 8, "null.Uint8")
+	case string:
+		n, err = parseSizedUint(x, 8, "null.Uint8")
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.Uint8: %v", value, value)
+	}
+	u.Uint8, u.Valid = uint8(n), err == nil
+	return err
+}
+
+// Value implements driver.Valuer.
+// It returns an int64, or nil if this Uint8 is null.
+func (u Uint8) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return int64(u.Uint8), nil
+}
+
+// SetValid changes this Uint8's value and also sets it to be non-null.
+func (u *Uint8) SetValid(n uint8) {
+	u.Uint8 = n
+	u.Valid = true
+}
+
+// SetNull sets this Uint8 to null, resetting its value to zero.
+func (u *Uint8) SetNull() {
+	u.Uint8 = 0
+	u.Valid = false
+}
+
+// Ptr returns a pointer to this Uint8's value, or a nil pointer if this Uint8 is null.
+func (u Uint8) Ptr() *uint8 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint8
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u Uint8) ValueOrZero() uint8 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint8
+}
+
+// IsNull returns true if this Uint8 is null.
+func (u Uint8) IsNull() bool {
+	return !u.Valid
+}
+
+// Null is the same as IsNull.
+func (u Uint8) Null() bool {
+	return !u.Valid
+}
+
+// IsValid returns true if this Uint8 is not null.
+func (u Uint8) IsValid() bool {
+	return u.Valid
+}
+
+// IsZero returns true for null Uint8s and for valid ones holding 0, for omitempty support.
+// Use IsValid to tell a null Uint8 from a valid zero one.
+func (u Uint8) IsZero() bool {
+	return !u.Valid || u.Uint8 == 0
+}
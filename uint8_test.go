@@ -0,0 +1,198 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestUint8From(t *testing.T) {
+	u := Uint8From(123)
+	assertUint8(t, u, "Uint8From()")
+
+	zero := Uint8From(0)
+	if !zero.Valid {
+		t.Error("Uint8From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestUint8FromPtr(t *testing.T) {
+	n := uint8(123)
+	u := Uint8FromPtr(&n)
+	assertUint8(t, u, "Uint8FromPtr()")
+
+	null := Uint8FromPtr(nil)
+	assertNullUint8(t, null, "Uint8FromPtr(nil)")
+}
+
+func TestUnmarshalUint8(t *testing.T) {
+	var u Uint8
+	err := json.Unmarshal([]byte(`123`), &u)
+	maybePanic(err)
+	assertUint8(t, u, "uint8 json")
+
+	var null Uint8
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUint8(t, null, "null json")
+
+	var badType Uint8
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint8(t, badType, "wrong type json")
+}
+
+func TestUnmarshalUint8Bounds(t *testing.T) {
+	var u Uint8
+	err := json.Unmarshal([]byte(strconv.FormatUint(math.MaxUint8, 10)), &u)
+	maybePanic(err)
+	if !u.Valid || u.Uint8 != math.MaxUint8 {
+		t.Errorf("bad max uint8 json: %d (valid: %t)\n", u.Uint8, u.Valid)
+	}
+
+	for _, s := range []string{"-1", "256"} {
+		err = json.Unmarshal([]byte(s), &u)
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullUint8(t, u, "out of range json")
+
+		err = u.UnmarshalText([]byte(s))
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullUint8(t, u, "out of range text")
+	}
+}
+
+func TestMarshalUint8(t *testing.T) {
+	u := Uint8From(math.MaxUint8)
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, strconv.FormatUint(math.MaxUint8, 10), "max json marshal")
+
+	data, err = u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, strconv.FormatUint(math.MaxUint8, 10), "max text marshal")
+
+	null := NewUint8(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestUint8Pointer(t *testing.T) {
+	u := Uint8From(123)
+	ptr := u.Ptr()
+	if *ptr != 123 {
+		t.Errorf("bad %s uint8: %#v ≠ %d\n", "pointer", ptr, 123)
+	}
+
+	null := NewUint8(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uint8: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUint8ValueOrZero(t *testing.T) {
+	valid := Uint8From(123)
+	if valid.ValueOrZero() != 123 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewUint8(123, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+	if invalid.IsZero() != true || valid.IsZero() != false {
+		t.Error("unexpected IsZero")
+	}
+	if !invalid.IsZero() || invalid.IsValid() {
+		t.Error("unexpected IsZero or IsValid for a null Uint8")
+	}
+	zero := Uint8From(0)
+	if !zero.IsZero() || !zero.IsValid() {
+		t.Error("unexpected IsZero or IsValid for a valid zero Uint8")
+	}
+}
+
+func TestUint8SetValid(t *testing.T) {
+	change := NewUint8(0, false)
+	assertNullUint8(t, change, "SetValid()")
+	change.SetValid(123)
+	assertUint8(t, change, "SetValid()")
+}
+
+func TestUint8Scan(t *testing.T) {
+	var u Uint8
+	err := u.Scan(int64(123))
+	maybePanic(err)
+	assertUint8(t, u, "scanned int64")
+
+	var b Uint8
+	err = b.Scan([]byte("123"))
+	maybePanic(err)
+	assertUint8(t, b, "scanned []byte")
+
+	var s Uint8
+	err = s.Scan("123")
+	maybePanic(err)
+	assertUint8(t, s, "scanned string")
+
+	var max Uint8
+	err = max.Scan(strconv.FormatUint(math.MaxUint8, 10))
+	maybePanic(err)
+	if max.Uint8 != math.MaxUint8 {
+		t.Errorf("bad scanned max uint8: %d\n", max.Uint8)
+	}
+	v, err := max.Value()
+	maybePanic(err)
+	var back Uint8
+	err = back.Scan(v)
+	maybePanic(err)
+	if back != max {
+		t.Errorf("bad max uint8 value round trip: %v ≠ %v\n", back, max)
+	}
+
+	var null Uint8
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUint8(t, null, "scanned null")
+
+	var negative Uint8
+	err = negative.Scan(int64(-1))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint8(t, negative, "scanned negative")
+
+	var big Uint8
+	err = big.Scan(int64(math.MaxUint8) + 1)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint8(t, big, "scanned overflow")
+}
+
+func assertUint8(t *testing.T, u Uint8, from string) {
+	if u.Uint8 != 123 {
+		t.Errorf("bad %s uint8: %d ≠ %d\n", from, u.Uint8, 123)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint8(t *testing.T, u Uint8, from string) {
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
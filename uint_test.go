@@ -0,0 +1,191 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestUintFrom(t *testing.T) {
+	u := UintFrom(123)
+	assertUint(t, u, "UintFrom()")
+
+	zero := UintFrom(0)
+	if !zero.Valid {
+		t.Error("UintFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestUintFromPtr(t *testing.T) {
+	n := uint(123)
+	u := UintFromPtr(&n)
+	assertUint(t, u, "UintFromPtr()")
+
+	null := UintFromPtr(nil)
+	assertNullUint(t, null, "UintFromPtr(nil)")
+}
+
+func TestUnmarshalUint(t *testing.T) {
+	var u Uint
+	err := json.Unmarshal([]byte(`123`), &u)
+	maybePanic(err)
+	assertUint(t, u, "uint json")
+
+	var null Uint
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUint(t, null, "null json")
+
+	var badType Uint
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint(t, badType, "wrong type json")
+}
+
+func TestUnmarshalUintBounds(t *testing.T) {
+	var u Uint
+	err := json.Unmarshal([]byte(strconv.FormatUint(math.MaxUint, 10)), &u)
+	maybePanic(err)
+	if !u.Valid || u.Uint != math.MaxUint {
+		t.Errorf("bad max uint json: %d (valid: %t)\n", u.Uint, u.Valid)
+	}
+
+	for _, s := range []string{"-1", strconv.FormatUint(math.MaxUint, 10) + "0"} {
+		err = json.Unmarshal([]byte(s), &u)
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullUint(t, u, "out of range json")
+
+		err = u.UnmarshalText([]byte(s))
+		if err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+		assertNullUint(t, u, "out of range text")
+	}
+}
+
+func TestMarshalUint(t *testing.T) {
+	u := UintFrom(math.MaxUint)
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, strconv.FormatUint(math.MaxUint, 10), "max json marshal")
+
+	data, err = u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, strconv.FormatUint(math.MaxUint, 10), "max text marshal")
+
+	null := NewUint(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestUintPointer(t *testing.T) {
+	u := UintFrom(123)
+	ptr := u.Ptr()
+	if *ptr != 123 {
+		t.Errorf("bad %s uint: %#v ≠ %d\n", "pointer", ptr, 123)
+	}
+
+	null := NewUint(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uint: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUintValueOrZero(t *testing.T) {
+	valid := UintFrom(123)
+	if valid.ValueOrZero() != 123 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewUint(123, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+	if invalid.IsZero() != true || valid.IsZero() != false {
+		t.Error("unexpected IsZero")
+	}
+	if !invalid.IsZero() || invalid.IsValid() {
+		t.Error("unexpected IsZero or IsValid for a null Uint")
+	}
+	zero := UintFrom(0)
+	if !zero.IsZero() || !zero.IsValid() {
+		t.Error("unexpected IsZero or IsValid for a valid zero Uint")
+	}
+}
+
+func TestUintSetValid(t *testing.T) {
+	change := NewUint(0, false)
+	assertNullUint(t, change, "SetValid()")
+	change.SetValid(123)
+	assertUint(t, change, "SetValid()")
+}
+
+func TestUintScan(t *testing.T) {
+	var u Uint
+	err := u.Scan(int64(123))
+	maybePanic(err)
+	assertUint(t, u, "scanned int64")
+
+	var b Uint
+	err = b.Scan([]byte("123"))
+	maybePanic(err)
+	assertUint(t, b, "scanned []byte")
+
+	var s Uint
+	err = s.Scan("123")
+	maybePanic(err)
+	assertUint(t, s, "scanned string")
+
+	var max Uint
+	err = max.Scan(strconv.FormatUint(math.MaxUint, 10))
+	maybePanic(err)
+	if max.Uint != math.MaxUint {
+		t.Errorf("bad scanned max uint: %d\n", max.Uint)
+	}
+	v, err := max.Value()
+	maybePanic(err)
+	var back Uint
+	err = back.Scan(v)
+	maybePanic(err)
+	if back != max {
+		t.Errorf("bad max uint value round trip: %v ≠ %v\n", back, max)
+	}
+
+	var null Uint
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUint(t, null, "scanned null")
+
+	var negative Uint
+	err = negative.Scan(int64(-1))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUint(t, negative, "scanned negative")
+}
+
+func assertUint(t *testing.T, u Uint, from string) {
+	if u.Uint != 123 {
+		t.Errorf("bad %s uint: %d ≠ %d\n", from, u.Uint, 123)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint(t *testing.T, u Uint, from string) {
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
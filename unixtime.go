@@ -0,0 +1,52 @@
+package null
+
+import (
+	"strconv"
+	"time"
+)
+
+// UnixTime is a nullable time.Time that marshals to JSON as a Unix timestamp.
+// It will marshal to null if null, and to a number of seconds since the Unix epoch otherwise,
+// or milliseconds if UnixMilli is set. Everything else, including decoding, works as it does for Time.
+type UnixTime struct {
+	Time
+}
+
+// NewUnixTime creates a new UnixTime.
+func NewUnixTime(t time.Time, valid bool) UnixTime {
+	return UnixTime{Time: NewTime(t, valid)}
+}
+
+// UnixTimeFrom creates a new UnixTime that will always be valid.
+func UnixTimeFrom(t time.Time) UnixTime {
+	return NewUnixTime(t, true)
+}
+
+// UnixTimeFromPtr creates a new UnixTime that will be null if t is nil.
+func UnixTimeFromPtr(t *time.Time) UnixTime {
+	if t == nil {
+		return NewUnixTime(time.Time{}, false)
+	}
+	return NewUnixTime(*t, true)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this UnixTime is null, or 0 if NullZero is set.
+func (t UnixTime) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(make([]byte, 0, 20))
+}
+
+// AppendJSON appends the JSON encoding of this UnixTime to dst, as MarshalJSON would encode it.
+// It overrides Time.AppendJSON, which would encode a string.
+func (t UnixTime) AppendJSON(dst []byte) ([]byte, error) {
+	if !t.Valid {
+		if NullZero {
+			return append(dst, '0'), nil
+		}
+		return append(dst, "null"...), nil
+	}
+	if UnixMilli {
+		return strconv.AppendInt(dst, t.Time.Time.UnixMilli(), 10), nil
+	}
+	return strconv.AppendInt(dst, t.Time.Time.Unix(), 10), nil
+}
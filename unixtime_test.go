@@ -0,0 +1,73 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var (
+	unixTimeJSON      = []byte(`1356124881`)
+	unixMilliTimeJSON = []byte(`1356124881000`)
+)
+
+func TestUnixTimeFromPtr(t *testing.T) {
+	ti := UnixTimeFromPtr(&timeValue)
+	assertTime(t, ti.Time, "UnixTimeFromPtr() time")
+
+	null := UnixTimeFromPtr(nil)
+	assertNullTime(t, null.Time, "UnixTimeFromPtr(nil)")
+}
+
+func TestMarshalUnixTime(t *testing.T) {
+	ti := UnixTimeFrom(timeValue)
+	data, err := json.Marshal(ti)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(unixTimeJSON), "seconds json marshal")
+
+	null := NewUnixTime(timeValue, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	defer func(v bool) { UnixMilli = v }(UnixMilli)
+	UnixMilli = true
+	data, err = json.Marshal(ti)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(unixMilliTimeJSON), "milliseconds json marshal")
+
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null milliseconds json marshal")
+}
+
+func TestUnmarshalUnixTime(t *testing.T) {
+	var ti UnixTime
+	err := json.Unmarshal(unixTimeJSON, &ti)
+	maybePanic(err)
+	assertTime(t, ti.Time, "seconds json")
+
+	var str UnixTime
+	err = json.Unmarshal(timeJSON, &str)
+	maybePanic(err)
+	assertTime(t, str.Time, "string json")
+
+	var null UnixTime
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullTime(t, null.Time, "null json")
+
+	defer func(v bool) { UnixMilli = v }(UnixMilli)
+	UnixMilli = true
+	var milli UnixTime
+	err = json.Unmarshal(unixMilliTimeJSON, &milli)
+	maybePanic(err)
+	assertTime(t, milli.Time, "milliseconds json")
+}
+
+func TestUnixTimeNullZero(t *testing.T) {
+	defer func(v bool) { NullZero = v }(NullZero)
+	NullZero = true
+	data, err := json.Marshal(NewUnixTime(timeValue, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null json marshal with NullZero")
+}
@@ -0,0 +1,170 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+)
+
+// URL is a nullable *url.URL. It supports SQL and JSON serialization.
+// It will marshal to null if null, and to the URL's string form otherwise.
+// Blank string input will be considered null, but input that url.Parse rejects is an error.
+type URL struct {
+	URL   *url.URL
+	Valid bool
+}
+
+// NewURL creates a new URL
+func NewURL(u *url.URL, valid bool) URL {
+	return URL{
+		URL:   u,
+		Valid: valid,
+	}
+}
+
+// URLFrom creates a new URL that will be valid unless u is nil.
+func URLFrom(u *url.URL) URL {
+	return NewURL(u, u != nil)
+}
+
+// URLFromString creates a new URL by parsing s with url.Parse.
+// It will be null if s is blank, and an error is returned if s is not a valid URL.
+func URLFromString(s string) (URL, error) {
+	var u URL
+	err := u.UnmarshalText([]byte(s))
+	return u, err
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports URL strings and null input. Blank string input produces a null URL.
+func (u *URL) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.URL")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		return u.UnmarshalText([]byte(x))
+	case nil:
+		u.Valid = false
+		return nil
+	}
+	u.Valid = false
+	return fmt.Errorf("json: cannot unmarshal %v into Go value of type null.URL", reflect.TypeOf(v).Name())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null URL if the input is blank or matches NullText.
+// It will return an error if url.Parse rejects the input.
+func (u *URL) UnmarshalText(text []byte) error {
+	if isNullText(text) {
+		u.Valid = false
+		return nil
+	}
+	var err error
+	u.URL, err = url.Parse(string(text))
+	u.Valid = err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this URL is null.
+func (u URL) MarshalJSON() ([]byte, error) {
+	if !u.Valid || u.URL == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(u.URL.String())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this URL is null.
+func (u URL) MarshalText() ([]byte, error) {
+	if !u.Valid || u.URL == nil {
+		return nullText(), nil
+	}
+	return []byte(u.URL.String()), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports URL strings and []byte.
+func (u *URL) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case []byte:
+		return u.UnmarshalText(x)
+	case string:
+		return u.UnmarshalText([]byte(x))
+	case nil:
+		u.Valid = false
+		return nil
+	}
+	u.Valid = false
+	return fmt.Errorf("null: cannot scan type %T into null.URL: %v", value, value)
+}
+
+// Value implements driver.Valuer.
+// It returns the string form of this URL, or nil if this URL is null.
+func (u URL) Value() (driver.Value, error) {
+	if !u.Valid || u.URL == nil {
+		return nil, nil
+	}
+	return u.URL.String(), nil
+}
+
+// SetValid changes this URL's value and also sets it to be non-null.
+func (u *URL) SetValid(v *url.URL) {
+	u.URL = v
+	u.Valid = v != nil
+}
+
+// SetNull sets this URL to null, resetting its value to zero.
+func (u *URL) SetNull() {
+	u.URL = nil
+	u.Valid = false
+}
+
+// Ptr returns this URL's value, or a nil pointer if this URL is null.
+func (u URL) Ptr() *url.URL {
+	if !u.Valid {
+		return nil
+	}
+	return u.URL
+}
+
+// ValueOrZero returns a copy of the inner value if valid, otherwise a zero url.URL.
+func (u URL) ValueOrZero() url.URL {
+	if !u.Valid || u.URL == nil {
+		return url.URL{}
+	}
+	return *u.URL
+}
+
+// String implements fmt.Stringer.
+// It returns the string form of this URL, or NullDisplay if null.
+func (u URL) String() string {
+	if !u.Valid || u.URL == nil {
+		return NullDisplay
+	}
+	return u.URL.String()
+}
+
+// IsNull returns true if this URL is null.
+func (u URL) IsNull() bool {
+	return !u.Valid
+}
+
+// Null is the same as IsNull.
+func (u URL) Null() bool {
+	return !u.Valid
+}
+
+// IsValid returns true if this URL is not null.
+func (u URL) IsValid() bool {
+	return u.Valid
+}
+
+// IsZero returns true for null URLs and for valid ones holding an empty URL, for omitempty support.
+// Use IsValid to tell a null URL from a valid zero one.
+func (u URL) IsZero() bool {
+	return !u.Valid || u.URL == nil || *u.URL == (url.URL{})
+}
@@ -0,0 +1,218 @@
+package null
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+var (
+	urlString      = "https://example.com/users?id=1#profile"
+	urlJSON        = []byte(`"` + urlString + `"`)
+	relativeString = "/hooks/incoming"
+)
+
+func TestURLFrom(t *testing.T) {
+	parsed, err := url.Parse(urlString)
+	maybePanic(err)
+	u := URLFrom(parsed)
+	assertURL(t, u, urlString, "URLFrom()")
+
+	null := URLFrom(nil)
+	assertNullURL(t, null, "URLFrom(nil)")
+}
+
+func TestURLFromString(t *testing.T) {
+	u, err := URLFromString(urlString)
+	maybePanic(err)
+	assertURL(t, u, urlString, "URLFromString() absolute")
+	if !u.URL.IsAbs() || u.URL.Host != "example.com" {
+		t.Errorf("bad parsed url: %#v\n", u.URL)
+	}
+
+	rel, err := URLFromString(relativeString)
+	maybePanic(err)
+	assertURL(t, rel, relativeString, "URLFromString() relative")
+	if rel.URL.IsAbs() {
+		t.Error("relative url should not be absolute")
+	}
+
+	null, err := URLFromString("")
+	maybePanic(err)
+	assertNullURL(t, null, "URLFromString(\"\")")
+
+	invalid, err := URLFromString("http://[::1")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullURL(t, invalid, "URLFromString() invalid")
+}
+
+func TestUnmarshalURL(t *testing.T) {
+	var u URL
+	err := json.Unmarshal(urlJSON, &u)
+	maybePanic(err)
+	assertURL(t, u, urlString, "url json")
+
+	var blank URL
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullURL(t, blank, "blank string json")
+
+	var null URL
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullURL(t, null, "null json")
+
+	var invalid URL
+	err = json.Unmarshal([]byte(`"%zz"`), &invalid)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullURL(t, invalid, "invalid url json")
+
+	var badType URL
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullURL(t, badType, "wrong type json")
+}
+
+func TestMarshalURL(t *testing.T) {
+	u, err := URLFromString(urlString)
+	maybePanic(err)
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(urlJSON), "non-empty json marshal")
+
+	data, err = u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, urlString, "non-empty text marshal")
+
+	var out URL
+	err = out.UnmarshalText(data)
+	maybePanic(err)
+	assertURL(t, out, urlString, "text round trip")
+
+	null := URLFrom(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestURLScan(t *testing.T) {
+	var s URL
+	err := s.Scan(urlString)
+	maybePanic(err)
+	assertURL(t, s, urlString, "scanned string")
+
+	var b URL
+	err = b.Scan([]byte(relativeString))
+	maybePanic(err)
+	assertURL(t, b, relativeString, "scanned []byte")
+
+	var null URL
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullURL(t, null, "scanned null")
+
+	var wrong URL
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullURL(t, wrong, "scanned int64")
+
+	v, err := s.Value()
+	maybePanic(err)
+	if v != urlString {
+		t.Errorf("bad url value: %v ≠ %v\n", v, urlString)
+	}
+
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null url value: %v ≠ nil\n", v)
+	}
+}
+
+func TestURLPointer(t *testing.T) {
+	u, err := URLFromString(urlString)
+	maybePanic(err)
+	ptr := u.Ptr()
+	if ptr == nil || ptr.String() != urlString {
+		t.Errorf("bad %s url: %#v ≠ %v\n", "pointer", ptr, urlString)
+	}
+
+	null := NewURL(u.URL, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s url: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestURLValueOrZero(t *testing.T) {
+	valid, err := URLFromString(urlString)
+	maybePanic(err)
+	if v := valid.ValueOrZero(); v.String() != urlString {
+		t.Error("unexpected ValueOrZero", v)
+	}
+
+	invalid := NewURL(valid.URL, false)
+	if v := invalid.ValueOrZero(); v != (url.URL{}) {
+		t.Error("unexpected ValueOrZero", v)
+	}
+}
+
+func TestURLIsZero(t *testing.T) {
+	u, err := URLFromString(urlString)
+	maybePanic(err)
+	if u.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := URLFrom(nil)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	if null.IsValid() {
+		t.Errorf("IsValid() should be false")
+	}
+
+	zero := URLFrom(&url.URL{})
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero value")
+	}
+	if !zero.IsValid() {
+		t.Errorf("IsValid() should be true")
+	}
+}
+
+func TestURLSetValid(t *testing.T) {
+	var change URL
+	assertNullURL(t, change, "SetValid()")
+	parsed, err := url.Parse(urlString)
+	maybePanic(err)
+	change.SetValid(parsed)
+	assertURL(t, change, urlString, "SetValid()")
+}
+
+func assertURL(t *testing.T, u URL, expected string, from string) {
+	if u.URL == nil || u.URL.String() != expected {
+		t.Errorf("bad %s url: %v ≠ %v\n", from, u.URL, expected)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullURL(t *testing.T, u URL, from string) {
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
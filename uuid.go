@@ -0,0 +1,222 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// UUID is a nullable UUID. It supports SQL and JSON serialization.
+// It will marshal to null if null, and to the lowercase canonical
+// "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx" form otherwise.
+// Blank string input will be considered null, but malformed input is an error.
+type UUID struct {
+	UUID  [16]byte
+	Valid bool
+}
+
+// NewUUID creates a new UUID
+func NewUUID(u [16]byte, valid bool) UUID {
+	return UUID{
+		UUID:  u,
+		Valid: valid,
+	}
+}
+
+// UUIDFrom creates a new UUID from its canonical string form.
+// It will be null if s is blank, and an error is returned if s is not a valid UUID.
+func UUIDFrom(s string) (UUID, error) {
+	var u UUID
+	err := u.UnmarshalText([]byte(s))
+	return u, err
+}
+
+// UUIDFromBytes creates a new UUID from its 16-byte binary form.
+// It will be null if b is nil, and an error is returned if b is not 16 bytes long.
+func UUIDFromBytes(b []byte) (UUID, error) {
+	var u UUID
+	if b == nil {
+		return u, nil
+	}
+	if len(b) != 16 {
+		return u, fmt.Errorf("null: invalid UUID length: %d bytes", len(b))
+	}
+	copy(u.UUID[:], b)
+	u.Valid = true
+	return u, nil
+}
+
+// UUIDFromPtr creates a new UUID that will be null if u is nil.
+func UUIDFromPtr(u *[16]byte) UUID {
+	if u == nil {
+		return NewUUID([16]byte{}, false)
+	}
+	return NewUUID(*u, true)
+}
+
+// parseUUID parses the canonical 36-character string form of a UUID.
+func parseUUID(s string) ([16]byte, error) {
+	var u [16]byte
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("null: invalid UUID: %q", s)
+	}
+	src := []byte(s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:36])
+	if _, err := hex.Decode(u[:], src); err != nil {
+		return u, fmt.Errorf("null: invalid UUID: %q", s)
+	}
+	return u, nil
+}
+
+// formatUUID returns the lowercase canonical string form of u.
+func formatUUID(u [16]byte) string {
+	buf := make([]byte, 36)
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports UUID string and null input. Blank string input produces a null UUID.
+func (u *UUID) UnmarshalJSON(data []byte) (err error) {
+	defer wrapUnmarshalError(&err, data, "null.UUID")
+	var v interface{}
+	json.Unmarshal(data, &v)
+	switch x := v.(type) {
+	case string:
+		return u.UnmarshalText([]byte(x))
+	case nil:
+		u.Valid = false
+		return nil
+	}
+	u.Valid = false
+	return fmt.Errorf("json: cannot unmarshal %v into Go value of type null.UUID", reflect.TypeOf(v).Name())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null UUID if the input is blank or matches NullText.
+// It will return an error if the input is not a canonical UUID string.
+func (u *UUID) UnmarshalText(text []byte) error {
+	if isNullText(text) {
+		u.Valid = false
+		return nil
+	}
+	var err error
+	u.UUID, err = parseUUID(string(text))
+	u.Valid = err == nil
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this UUID is null.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + formatUUID(u.UUID) + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode NullText, blank by default, if this UUID is null.
+func (u UUID) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return nullText(), nil
+	}
+	return []byte(formatUUID(u.UUID)), nil
+}
+
+// Scan implements sql.Scanner.
+// It supports the 16-byte binary form as []byte, and the string form as string or []byte.
+func (u *UUID) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case []byte:
+		if len(x) == 16 {
+			copy(u.UUID[:], x)
+			u.Valid = true
+			return nil
+		}
+		return u.UnmarshalText(x)
+	case string:
+		return u.UnmarshalText([]byte(x))
+	case nil:
+		u.Valid = false
+		return nil
+	}
+	u.Valid = false
+	return fmt.Errorf("null: cannot scan type %T into null.UUID: %v", value, value)
+}
+
+// Value implements driver.Valuer.
+// It returns the canonical string form, or nil if this UUID is null.
+func (u UUID) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return formatUUID(u.UUID), nil
+}
+
+// String implements fmt.Stringer.
+// It returns the canonical string form, or NullDisplay if null.
+func (u UUID) String() string {
+	if !u.Valid {
+		return NullDisplay
+	}
+	return formatUUID(u.UUID)
+}
+
+// SetValid changes this UUID's value and also sets it to be non-null.
+func (u *UUID) SetValid(v [16]byte) {
+	u.UUID = v
+	u.Valid = true
+}
+
+// SetNull sets this UUID to null, resetting its value to zero.
+func (u *UUID) SetNull() {
+	u.UUID = [16]byte{}
+	u.Valid = false
+}
+
+// Ptr returns a pointer to this UUID's value, or a nil pointer if this UUID is null.
+func (u UUID) Ptr() *[16]byte {
+	if !u.Valid {
+		return nil
+	}
+	return &u.UUID
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the all-zero UUID.
+func (u UUID) ValueOrZero() [16]byte {
+	if !u.Valid {
+		return [16]byte{}
+	}
+	return u.UUID
+}
+
+// IsNull returns true if this UUID is null.
+func (u UUID) IsNull() bool {
+	return !u.Valid
+}
+
+// Null is the same as IsNull.
+func (u UUID) Null() bool {
+	return !u.Valid
+}
+
+// IsValid returns true if this UUID is not null.
+func (u UUID) IsValid() bool {
+	return u.Valid
+}
+
+// IsZero returns true for null UUIDs and for valid ones holding the all-zero UUID, for omitempty support.
+// Use IsValid to tell a null UUID from a valid zero one.
+func (u UUID) IsZero() bool {
+	return !u.Valid || u.UUID == [16]byte{}
+}
@@ -0,0 +1,209 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var (
+	uuidString = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	uuidJSON   = []byte(`"` + uuidString + `"`)
+	uuidValue  = [16]byte{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}
+)
+
+func TestUUIDFrom(t *testing.T) {
+	u, err := UUIDFrom(uuidString)
+	maybePanic(err)
+	assertUUID(t, u, "UUIDFrom()")
+
+	upper, err := UUIDFrom("F47AC10B-58CC-4372-A567-0E02B2C3D479")
+	maybePanic(err)
+	assertUUID(t, upper, "UUIDFrom() uppercase")
+
+	null, err := UUIDFrom("")
+	maybePanic(err)
+	assertNullUUID(t, null, "UUIDFrom(\"\")")
+
+	for _, s := range []string{"hello", "f47ac10b58cc4372a5670e02b2c3d479", "f47ac10b-58cc-4372-a567-0e02b2c3d47z", "f47ac10b_58cc_4372_a567_0e02b2c3d479"} {
+		invalid, err := UUIDFrom(s)
+		if err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+		assertNullUUID(t, invalid, "UUIDFrom() invalid")
+	}
+}
+
+func TestUUIDFromBytes(t *testing.T) {
+	u, err := UUIDFromBytes(uuidValue[:])
+	maybePanic(err)
+	assertUUID(t, u, "UUIDFromBytes()")
+
+	null, err := UUIDFromBytes(nil)
+	maybePanic(err)
+	assertNullUUID(t, null, "UUIDFromBytes(nil)")
+
+	_, err = UUIDFromBytes([]byte{1, 2, 3})
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestUUIDFromPtr(t *testing.T) {
+	v := uuidValue
+	u := UUIDFromPtr(&v)
+	assertUUID(t, u, "UUIDFromPtr()")
+
+	null := UUIDFromPtr(nil)
+	assertNullUUID(t, null, "UUIDFromPtr(nil)")
+}
+
+func TestUnmarshalUUID(t *testing.T) {
+	var u UUID
+	err := json.Unmarshal(uuidJSON, &u)
+	maybePanic(err)
+	assertUUID(t, u, "uuid json")
+
+	var blank UUID
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullUUID(t, blank, "blank string json")
+
+	var null UUID
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUUID(t, null, "null json")
+
+	var invalid UUID
+	err = json.Unmarshal(stringJSON, &invalid)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUUID(t, invalid, "invalid uuid json")
+
+	var badType UUID
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUUID(t, badType, "wrong type json")
+}
+
+func TestMarshalUUID(t *testing.T) {
+	u := NewUUID(uuidValue, true)
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(uuidJSON), "non-empty json marshal")
+
+	var out UUID
+	err = json.Unmarshal(data, &out)
+	maybePanic(err)
+	assertUUID(t, out, "round trip json")
+
+	data, err = u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, uuidString, "non-empty text marshal")
+
+	null := UUIDFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestUUIDScan(t *testing.T) {
+	var bin UUID
+	err := bin.Scan(uuidValue[:])
+	maybePanic(err)
+	assertUUID(t, bin, "scanned binary")
+
+	var b UUID
+	err = b.Scan([]byte(uuidString))
+	maybePanic(err)
+	assertUUID(t, b, "scanned []byte string")
+
+	var s UUID
+	err = s.Scan(uuidString)
+	maybePanic(err)
+	assertUUID(t, s, "scanned string")
+
+	var null UUID
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUUID(t, null, "scanned null")
+
+	var invalid UUID
+	err = invalid.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUUID(t, invalid, "scanned invalid")
+
+	v, err := s.Value()
+	maybePanic(err)
+	if v != uuidString {
+		t.Errorf("bad uuid value: %v ≠ %v\n", v, uuidString)
+	}
+}
+
+func TestUUIDString(t *testing.T) {
+	if s := NewUUID(uuidValue, true).String(); s != uuidString {
+		t.Errorf("bad String() output: %s\n", s)
+	}
+	if s := NewUUID(uuidValue, false).String(); s != "null" {
+		t.Errorf("bad null String() output: %s\n", s)
+	}
+}
+
+func TestUUIDPointer(t *testing.T) {
+	u := NewUUID(uuidValue, true)
+	ptr := u.Ptr()
+	if *ptr != uuidValue {
+		t.Errorf("bad %s uuid: %#v ≠ %v\n", "pointer", ptr, uuidValue)
+	}
+
+	null := NewUUID(uuidValue, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uuid: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUUIDValueOrZero(t *testing.T) {
+	valid := NewUUID(uuidValue, true)
+	if valid.ValueOrZero() != uuidValue {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewUUID(uuidValue, false)
+	if invalid.ValueOrZero() != [16]byte{} {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+	if !invalid.IsZero() || valid.IsZero() {
+		t.Error("unexpected IsZero")
+	}
+	zero := NewUUID([16]byte{}, true)
+	if !zero.IsZero() || !zero.IsValid() || invalid.IsValid() {
+		t.Error("unexpected IsZero or IsValid")
+	}
+}
+
+func TestUUIDSetValid(t *testing.T) {
+	var change UUID
+	assertNullUUID(t, change, "SetValid()")
+	change.SetValid(uuidValue)
+	assertUUID(t, change, "SetValid()")
+}
+
+func assertUUID(t *testing.T, u UUID, from string) {
+	if u.UUID != uuidValue {
+		t.Errorf("bad %s uuid: %x ≠ %x\n", from, u.UUID, uuidValue)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUUID(t *testing.T, u UUID, from string) {
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,77 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math/big"
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// validValues returns a valid value of every driver.Valuer type in this package.
+func validValues() []driver.Valuer {
+	uuid, err := UUIDFrom("123e4567-e89b-12d3-a456-426614174000")
+	maybePanic(err)
+	u, err := url.Parse("https://example.com/path?q=1")
+	maybePanic(err)
+
+	return []driver.Valuer{
+		StringFrom("test"),
+		IntFrom(12345),
+		FloatFrom(1.2345),
+		BoolFrom(true),
+		TimeFrom(timeValue),
+		Int8From(-8),
+		Int16From(-16),
+		Int32From(-32),
+		Int64From(-64),
+		UintFrom(1),
+		Uint8From(8),
+		Uint16From(16),
+		Uint32From(32),
+		Uint64From(1 << 63),
+		ByteFrom('b'),
+		BytesFrom([]byte("bytes")),
+		RuneFrom('€'),
+		ColorFrom(0x1a2b3c),
+		NewPercent(0.25, true),
+		NewStringArray([]String{StringFrom("a,b"), NewString("", false)}, true),
+		DateFrom(dateValue),
+		DurationFrom(durationValue),
+		BigIntFrom(big.NewInt(-12345)),
+		JSONFrom([]byte(`{"a":1}`)),
+		IPFrom(net.ParseIP("192.0.2.1")),
+		URLFrom(u),
+		uuid,
+	}
+}
+
+func TestValueScanRoundTrip(t *testing.T) {
+	for _, v := range validValues() {
+		typ := reflect.TypeOf(v)
+		assertValueRoundTrip(t, v, typ.Name())
+		assertValueRoundTrip(t, reflect.Zero(typ).Interface().(driver.Valuer), "null "+typ.Name())
+	}
+}
+
+// assertValueRoundTrip checks that v's driver.Value is a valid driver type,
+// nil if v is null, and that scanning it into a new value of v's type gives v back.
+func assertValueRoundTrip(t *testing.T, v driver.Valuer, from string) {
+	t.Helper()
+	value, err := v.Value()
+	maybePanic(err)
+	if !driver.IsValue(value) {
+		t.Errorf("bad %s value: %T is not a driver.Value\n", from, value)
+		return
+	}
+	if reflect.ValueOf(v).FieldByName("Valid").Bool() == (value == nil) {
+		t.Errorf("bad %s value: %#v\n", from, value)
+	}
+	dest := reflect.New(reflect.TypeOf(v))
+	maybePanic(dest.Interface().(sql.Scanner).Scan(value))
+	if got := dest.Elem().Interface(); !reflect.DeepEqual(got, v) {
+		t.Errorf("bad %s round trip: %#v ≠ %#v\n", from, got, v)
+	}
+}
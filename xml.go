@@ -0,0 +1,185 @@
+package null
+
+import (
+	"encoding/xml"
+	"strconv"
+)
+
+// XMLNilStyle describes how a null value is encoded as an XML element.
+type XMLNilStyle int
+
+const (
+	// XMLNilOmit leaves out the element entirely.
+	XMLNilOmit XMLNilStyle = iota
+	// XMLNilEmpty encodes an empty element.
+	XMLNilEmpty
+	// XMLNilXSI encodes an empty element with xsi:nil="true".
+	XMLNilXSI
+)
+
+// XMLNil is the style used to encode null values as XML elements.
+// It defaults to XMLNilOmit and applies to every type in the process.
+// Null attributes are always omitted.
+var XMLNil = XMLNilOmit
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// marshalXML encodes text as the content of start, or a null element in the XMLNil style.
+func marshalXML(e *xml.Encoder, start xml.StartElement, valid bool, text string) error {
+	if !valid {
+		switch XMLNil {
+		case XMLNilOmit:
+			return nil
+		case XMLNilXSI:
+			start.Attr = append(start.Attr,
+				xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+				xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+			)
+		}
+		return e.EncodeElement("", start)
+	}
+	return e.EncodeElement(text, start)
+}
+
+// unmarshalXML decodes the content of start and passes it to unmarshalText.
+// Elements with xsi:nil="true" are passed as blank text, which all types treat as null.
+func unmarshalXML(d *xml.Decoder, start xml.StartElement, unmarshalText func([]byte) error) error {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") &&
+			(attr.Value == "true" || attr.Value == "1") {
+			if err := d.Skip(); err != nil {
+				return err
+			}
+			return unmarshalText(nil)
+		}
+	}
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	return unmarshalText([]byte(text))
+}
+
+// marshalXMLAttr encodes text as an attribute, or omits the attribute if null.
+func marshalXMLAttr(name xml.Name, valid bool, text string) (xml.Attr, error) {
+	if !valid {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr{Name: name, Value: text}, nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode a null element in the XMLNil style if this String is null.
+func (s String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, s.Valid, s.String)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// Empty elements and elements with xsi:nil="true" produce a null String.
+func (s *String) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, s.UnmarshalText)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this String is null.
+func (s String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, s.Valid, s.String)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (s *String) UnmarshalXMLAttr(attr xml.Attr) error {
+	return s.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode a null element in the XMLNil style if this Int is null.
+func (i Int) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i.Valid, strconv.FormatInt(i.Int64, 10))
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// Empty elements and elements with xsi:nil="true" produce a null Int.
+func (i *Int) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, i.UnmarshalText)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Int is null.
+func (i Int) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i.Valid, strconv.FormatInt(i.Int64, 10))
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Int) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode a null element in the XMLNil style if this Float is null.
+func (f Float) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, f.Valid, strconv.FormatFloat(f.Float64, 'f', -1, 64))
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// Empty elements and elements with xsi:nil="true" produce a null Float.
+func (f *Float) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, f.UnmarshalText)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Float is null.
+func (f Float) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, f.Valid, strconv.FormatFloat(f.Float64, 'f', -1, 64))
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (f *Float) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode a null element in the XMLNil style if this Bool is null.
+func (b Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b.Valid, strconv.FormatBool(b.Bool))
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// Empty elements and elements with xsi:nil="true" produce a null Bool.
+func (b *Bool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, b.UnmarshalText)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Bool is null.
+func (b Bool) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b.Valid, strconv.FormatBool(b.Bool))
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *Bool) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode a null element in the XMLNil style if this Time is null,
+// and a timestamp in TimeFormat, "infinity", or "-infinity" otherwise.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, t.Valid, formatTime(t.Time))
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// Empty elements and elements with xsi:nil="true" produce a null Time.
+func (t *Time) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, t.UnmarshalText)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Time is null.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t.Valid, formatTime(t.Time))
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
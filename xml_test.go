@@ -0,0 +1,133 @@
+package null
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+type xmlRecord struct {
+	XMLName xml.Name `xml:"record"`
+	ID      Int      `xml:"id,attr"`
+	String  String   `xml:"string"`
+	Int     Int      `xml:"int"`
+	Float   Float    `xml:"float"`
+	Bool    Bool     `xml:"bool"`
+	Time    Time     `xml:"time"`
+}
+
+func validXMLRecord() xmlRecord {
+	return xmlRecord{
+		ID:     IntFrom(1),
+		String: StringFrom("test"),
+		Int:    IntFrom(12345),
+		Float:  FloatFrom(1.2345),
+		Bool:   BoolFrom(true),
+		Time:   TimeFrom(timeValue),
+	}
+}
+
+const validXML = `<record id="1"><string>test</string><int>12345</int><float>1.2345</float><bool>true</bool><time>2012-12-21T21:21:21Z</time></record>`
+
+func TestMarshalXML(t *testing.T) {
+	data, err := xml.Marshal(validXMLRecord())
+	maybePanic(err)
+	assertJSONEquals(t, data, validXML, "valid xml marshal")
+}
+
+func TestMarshalXMLNil(t *testing.T) {
+	defer func(old XMLNilStyle) { XMLNil = old }(XMLNil)
+
+	XMLNil = XMLNilOmit
+	data, err := xml.Marshal(xmlRecord{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `<record></record>`, "omitted null xml marshal")
+
+	XMLNil = XMLNilEmpty
+	data, err = xml.Marshal(xmlRecord{Int: NewInt(0, false)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `<record><string></string><int></int><float></float><bool></bool><time></time></record>`, "empty null xml marshal")
+
+	XMLNil = XMLNilXSI
+	data, err = xml.Marshal(struct {
+		XMLName xml.Name `xml:"record"`
+		Int     Int      `xml:"int"`
+	}{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `<record><int xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></int></record>`, "xsi:nil null xml marshal")
+}
+
+func TestUnmarshalXML(t *testing.T) {
+	var r xmlRecord
+	err := xml.Unmarshal([]byte(validXML), &r)
+	maybePanic(err)
+	assertStr(t, r.String, "string xml")
+	assertInt(t, r.Int, "int xml")
+	assertFloat(t, r.Float, "float xml")
+	assertBool(t, r.Bool, "bool xml")
+	assertTime(t, r.Time, "time xml")
+	if !r.ID.Valid || r.ID.Int64 != 1 {
+		t.Errorf("bad xml attr: %v (valid: %t)\n", r.ID.Int64, r.ID.Valid)
+	}
+}
+
+func TestUnmarshalXMLNull(t *testing.T) {
+	var empty xmlRecord
+	err := xml.Unmarshal([]byte(`<record><string></string><int/><float></float><bool></bool><time></time></record>`), &empty)
+	maybePanic(err)
+	assertNullStr(t, empty.String, "empty string xml")
+	assertNullInt(t, empty.Int, "empty int xml")
+	assertNullFloat(t, empty.Float, "empty float xml")
+	assertNullBool(t, empty.Bool, "empty bool xml")
+	assertNullTime(t, empty.Time, "empty time xml")
+	assertNullInt(t, empty.ID, "absent attr xml")
+
+	r := validXMLRecord()
+	err = xml.Unmarshal([]byte(`<record xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">`+
+		`<string xsi:nil="true"/><int xsi:nil="true"></int><float xsi:nil="true"/><bool xsi:nil="true"/><time xsi:nil="true"/>`+
+		`</record>`), &r)
+	maybePanic(err)
+	assertNullStr(t, r.String, "xsi:nil string xml")
+	assertNullInt(t, r.Int, "xsi:nil int xml")
+	assertNullFloat(t, r.Float, "xsi:nil float xml")
+	assertNullBool(t, r.Bool, "xsi:nil bool xml")
+	assertNullTime(t, r.Time, "xsi:nil time xml")
+}
+
+func TestUnmarshalXMLBadType(t *testing.T) {
+	var r xmlRecord
+	err := xml.Unmarshal([]byte(`<record><int>hello</int></record>`), &r)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt(t, r.Int, "wrong type xml")
+}
+
+func TestXMLTimeInfinity(t *testing.T) {
+	type record struct {
+		XMLName xml.Name `xml:"record"`
+		Attr    Time     `xml:"at,attr"`
+		Time    Time     `xml:"time"`
+	}
+	for _, tc := range []struct {
+		value time.Time
+		text  string
+	}{
+		{PositiveInfinity, "infinity"},
+		{NegativeInfinity, "-infinity"},
+	} {
+		data, err := xml.Marshal(record{Attr: TimeFrom(tc.value), Time: TimeFrom(tc.value)})
+		maybePanic(err)
+		assertJSONEquals(t, data, `<record at="`+tc.text+`"><time>`+tc.text+`</time></record>`, tc.text+" xml marshal")
+
+		var out record
+		err = xml.Unmarshal(data, &out)
+		maybePanic(err)
+		if !out.Attr.Valid || !out.Attr.Time.Equal(tc.value) {
+			t.Errorf("bad %s attr round trip: %v", tc.text, out.Attr)
+		}
+		if !out.Time.Valid || !out.Time.Time.Equal(tc.value) {
+			t.Errorf("bad %s element round trip: %v", tc.text, out.Time)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+//go:build yaml
+
+package null
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// This file adds YAML support using gopkg.in/yaml.v3.
+// It is only built with the yaml build tag, so the core package has no YAML dependency.
+
+// yamlNull reports whether node is a YAML null (null, ~ or empty).
+func yamlNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null"
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this String is null.
+func (s String) MarshalYAML() (interface{}, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.String, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports string and null input. Blank string input produces a null String.
+func (s *String) UnmarshalYAML(node *yaml.Node) error {
+	if yamlNull(node) {
+		s.Valid = false
+		return nil
+	}
+	err := node.Decode(&s.String)
+	s.Valid = err == nil && s.String != ""
+	return err
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Int is null.
+func (i Int) MarshalYAML() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return i.Int64, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports integer and null input.
+func (i *Int) UnmarshalYAML(node *yaml.Node) error {
+	if yamlNull(node) {
+		i.Valid = false
+		return nil
+	}
+	err := node.Decode(&i.Int64)
+	i.Valid = err == nil
+	return err
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Float is null.
+func (f Float) MarshalYAML() (interface{}, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return f.Float64, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports number and null input.
+func (f *Float) UnmarshalYAML(node *yaml.Node) error {
+	if yamlNull(node) {
+		f.Valid = false
+		return nil
+	}
+	err := node.Decode(&f.Float64)
+	f.Valid = err == nil
+	return err
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalYAML() (interface{}, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.Bool, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports boolean and null input.
+func (b *Bool) UnmarshalYAML(node *yaml.Node) error {
+	if yamlNull(node) {
+		b.Valid = false
+		return nil
+	}
+	err := node.Decode(&b.Bool)
+	b.Valid = err == nil
+	return err
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Time is null, and a string in TimeFormat, "infinity", or "-infinity" otherwise.
+func (t Time) MarshalYAML() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return formatTime(t.Time), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports timestamps in TimeFormat or any of TimeFormats, and null input.
+// Blank string input produces a null Time.
+func (t *Time) UnmarshalYAML(node *yaml.Node) error {
+	if yamlNull(node) {
+		t.Valid = false
+		return nil
+	}
+	var str string
+	if err := node.Decode(&str); err != nil {
+		t.Valid = false
+		return err
+	}
+	return t.UnmarshalText([]byte(str))
+}
@@ -0,0 +1,104 @@
+//go:build yaml
+
+package null
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+type yamlRecord struct {
+	String String `yaml:"string"`
+	Int    Int    `yaml:"int"`
+	Float  Float  `yaml:"float"`
+	Bool   Bool   `yaml:"bool"`
+	Time   Time   `yaml:"time"`
+}
+
+func TestMarshalYAML(t *testing.T) {
+	valid := yamlRecord{
+		String: StringFrom("test"),
+		Int:    IntFrom(12345),
+		Float:  FloatFrom(1.2345),
+		Bool:   BoolFrom(true),
+		Time:   TimeFrom(timeValue),
+	}
+	data, err := yaml.Marshal(valid)
+	maybePanic(err)
+	assertJSONEquals(t, data, "string: test\nint: 12345\nfloat: 1.2345\nbool: true\ntime: \""+timeString+"\"\n", "valid yaml marshal")
+
+	data, err = yaml.Marshal(yamlRecord{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "string: null\nint: null\nfloat: null\nbool: null\ntime: null\n", "null yaml marshal")
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	var r yamlRecord
+	err := yaml.Unmarshal([]byte("string: test\nint: 12345\nfloat: 1.2345\nbool: true\ntime: "+timeString+"\n"), &r)
+	maybePanic(err)
+	assertStr(t, r.String, "string yaml")
+	assertInt(t, r.Int, "int yaml")
+	assertFloat(t, r.Float, "float yaml")
+	assertBool(t, r.Bool, "bool yaml")
+	assertTime(t, r.Time, "time yaml")
+
+	var null yamlRecord
+	err = yaml.Unmarshal([]byte("string: null\nint: ~\nfloat:\nbool: null\ntime: ~\n"), &null)
+	maybePanic(err)
+	assertNullStr(t, null.String, "null string yaml")
+	assertNullInt(t, null.Int, "null int yaml")
+	assertNullFloat(t, null.Float, "null float yaml")
+	assertNullBool(t, null.Bool, "null bool yaml")
+	assertNullTime(t, null.Time, "null time yaml")
+
+	var bad yamlRecord
+	err = yaml.Unmarshal([]byte("int: hello\n"), &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt(t, bad.Int, "wrong type yaml")
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	in := yamlRecord{
+		String: StringFrom("test"),
+		Int:    NewInt(0, false),
+		Float:  FloatFrom(1.2345),
+		Bool:   BoolFrom(false),
+		Time:   TimeFrom(timeValue),
+	}
+	data, err := yaml.Marshal(in)
+	maybePanic(err)
+
+	var out yamlRecord
+	err = yaml.Unmarshal(data, &out)
+	maybePanic(err)
+	assertStr(t, out.String, "round trip string")
+	assertNullInt(t, out.Int, "round trip int")
+	assertFloat(t, out.Float, "round trip float")
+	assertFalseBool(t, out.Bool, "round trip bool")
+	assertTime(t, out.Time, "round trip time")
+}
+
+func TestYAMLTimeInfinity(t *testing.T) {
+	for _, tc := range []struct {
+		value time.Time
+		text  string
+	}{
+		{PositiveInfinity, "infinity"},
+		{NegativeInfinity, "-infinity"},
+	} {
+		data, err := yaml.Marshal(yamlRecord{Time: TimeFrom(tc.value)})
+		maybePanic(err)
+		assertJSONEquals(t, data, "string: null\nint: null\nfloat: null\nbool: null\ntime: "+tc.text+"\n", tc.text+" yaml marshal")
+
+		var out yamlRecord
+		err = yaml.Unmarshal(data, &out)
+		maybePanic(err)
+		if !out.Time.Valid || !out.Time.Time.Equal(tc.value) {
+			t.Errorf("bad %s round trip: %v", tc.text, out.Time)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 )
 
 // Bool is a nullable bool. False input is considered null.
@@ -37,9 +38,19 @@ func BoolFromPtr(b *bool) Bool {
 	return NewBool(*b, true)
 }
 
+// BoolFromString creates a new Bool by parsing s with strconv.ParseBool.
+// It will be null if s is blank or false, and an error is returned if s is not a boolean.
+func BoolFromString(s string) (Bool, error) {
+	if s == "" {
+		return NewBool(false, false), nil
+	}
+	b, err := strconv.ParseBool(s)
+	return NewBool(b, err == nil && b), err
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // "false" will be considered a null Bool.
-// It also supports unmarshalling a sql.NullBool.
+// It also supports unmarshalling a sql.NullBool, which is null unless its Valid field is true.
 func (b *Bool) UnmarshalJSON(data []byte) error {
 	var err error
 	var v interface{}
@@ -47,7 +58,9 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 	switch x := v.(type) {
 	case bool:
 		b.Bool = x
+		b.Valid = true
 	case map[string]interface{}:
+		b.NullBool = sql.NullBool{}
 		err = json.Unmarshal(data, &b.NullBool)
 	case nil:
 		b.Valid = false
@@ -55,7 +68,7 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 	default:
 		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type zero.Bool", reflect.TypeOf(v).Name())
 	}
-	b.Valid = (err == nil) && b.Bool
+	b.Valid = (err == nil) && b.Valid && b.Bool
 	return err
 }
 
@@ -112,6 +125,43 @@ func (b Bool) Ptr() *bool {
 	return &b.Bool
 }
 
+// SetNull sets this Bool to null, resetting its value to false.
+func (b *Bool) SetNull() {
+	b.Bool = false
+	b.Valid = false
+}
+
+// ValueOrZero returns the inner value if valid, otherwise false.
+func (b Bool) ValueOrZero() bool {
+	return b.Valid && b.Bool
+}
+
+// Equal returns true if both booleans are true and valid, or if both are either null or false.
+func (b Bool) Equal(other Bool) bool {
+	return b.ValueOrZero() == other.ValueOrZero()
+}
+
+// Changed returns true if this Bool and old are not Equal.
+// Going from null to false, or back, is not a change.
+func (b Bool) Changed(old Bool) bool {
+	return !b.Equal(old)
+}
+
+// IsNull returns true if this Bool is null.
+func (b Bool) IsNull() bool {
+	return !b.Valid
+}
+
+// Null is the same as IsNull.
+func (b Bool) Null() bool {
+	return !b.Valid
+}
+
+// IsValid returns true if this Bool is not null.
+func (b Bool) IsValid() bool {
+	return b.Valid
+}
+
 // IsZero returns true for null or zero Bools, for future omitempty support (Go 1.4?)
 func (b Bool) IsZero() bool {
 	return !b.Valid || !b.Bool
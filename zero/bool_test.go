@@ -145,6 +145,13 @@ func TestBoolIsZero(t *testing.T) {
 	if !zero.IsZero() {
 		t.Errorf("IsZero() should be true")
 	}
+
+	if !NewBool(false, true).IsValid() {
+		t.Errorf("IsValid() should be true for a zero value")
+	}
+	if NewBool(false, false).IsValid() {
+		t.Errorf("IsValid() should be false for null")
+	}
 }
 
 func TestBoolSetValid(t *testing.T) {
@@ -166,9 +173,68 @@ func TestBoolScan(t *testing.T) {
 	assertNullBool(t, null, "scanned null")
 }
 
+func TestBoolFromString(t *testing.T) {
+	b, err := BoolFromString("true")
+	maybePanic(err)
+	assertBool(t, b, "BoolFromString()")
+
+	for _, s := range []string{"", "false"} {
+		null, err := BoolFromString(s)
+		maybePanic(err)
+		assertNullBool(t, null, "BoolFromString("+s+")")
+	}
+
+	if _, err := BoolFromString("maybe"); err == nil {
+		t.Error("expected error for non-boolean input")
+	}
+}
+
+func TestBoolValueOrZero(t *testing.T) {
+	valid := BoolFrom(true)
+	if !valid.ValueOrZero() {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewBool(true, false)
+	if invalid.ValueOrZero() {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestBoolEqual(t *testing.T) {
+	if !BoolFrom(true).Equal(BoolFrom(true)) {
+		t.Error("true bools should be Equal")
+	}
+	if BoolFrom(true).Equal(NewBool(false, true)) {
+		t.Error("true and false bools should not be Equal")
+	}
+	if !NewBool(false, false).Equal(NewBool(false, true)) {
+		t.Error("null and false bools should be Equal")
+	}
+	if !NewBool(true, false).Equal(NewBool(false, false)) {
+		t.Error("null bools should be Equal regardless of their value")
+	}
+}
+
+func TestBoolSetNull(t *testing.T) {
+	b := BoolFrom(true)
+	b.SetNull()
+	assertNullBool(t, b, "SetNull()")
+	if b.Bool || !b.IsNull() {
+		t.Errorf("SetNull() left %#v", b)
+	}
+}
+
+func TestUnmarshalBoolObjectInvalid(t *testing.T) {
+	var b Bool
+	err := json.Unmarshal([]byte(`{"Bool":true,"Valid":false}`), &b)
+	maybePanic(err)
+	assertNullBool(t, b, "sql.NullBool json with Valid false")
+}
+
 func assertBool(t *testing.T, b Bool, from string) {
 	if b.Bool != true {
-		t.Errorf("bad %s bool: %d ≠ %v\n", from, b.Bool, true)
+		t.Errorf("bad %s bool: %v ≠ %v\n", from, b.Bool, true)
 	}
 	if !b.Valid {
 		t.Error(from, "is invalid, but should be valid")
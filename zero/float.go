@@ -38,10 +38,20 @@ func FloatFromPtr(f *float64) Float {
 	return NewFloat(*f, true)
 }
 
+// FloatFromString creates a new Float by parsing s with strconv.ParseFloat.
+// It will be null if s is blank or zero, and an error is returned if s is not a number.
+func FloatFromString(s string) (Float, error) {
+	if s == "" {
+		return NewFloat(0, false), nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	return NewFloat(f, err == nil && f != 0), err
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Float.
-// It also supports unmarshalling a sql.NullFloat64.
+// It also supports unmarshalling a sql.NullFloat64, which is null unless its Valid field is true.
 func (f *Float) UnmarshalJSON(data []byte) error {
 	var err error
 	var v interface{}
@@ -49,7 +59,9 @@ func (f *Float) UnmarshalJSON(data []byte) error {
 	switch x := v.(type) {
 	case float64:
 		f.Float64 = x
+		f.Valid = true
 	case map[string]interface{}:
+		f.NullFloat64 = sql.NullFloat64{}
 		err = json.Unmarshal(data, &f.NullFloat64)
 	case nil:
 		f.Valid = false
@@ -57,7 +69,7 @@ func (f *Float) UnmarshalJSON(data []byte) error {
 	default:
 		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type zero.Float", reflect.TypeOf(v).Name())
 	}
-	f.Valid = (err == nil) && (f.Float64 != 0)
+	f.Valid = (err == nil) && f.Valid && (f.Float64 != 0)
 	return err
 }
 
@@ -110,6 +122,46 @@ func (f Float) Ptr() *float64 {
 	return &f.Float64
 }
 
+// SetNull sets this Float to null, resetting its value to zero.
+func (f *Float) SetNull() {
+	f.Float64 = 0
+	f.Valid = false
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (f Float) ValueOrZero() float64 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Float64
+}
+
+// Equal returns true if both floats have the same value or are both either null or zero.
+func (f Float) Equal(other Float) bool {
+	return f.ValueOrZero() == other.ValueOrZero()
+}
+
+// Changed returns true if this Float and old are not Equal.
+// Going from null to zero, or back, is not a change.
+func (f Float) Changed(old Float) bool {
+	return !f.Equal(old)
+}
+
+// IsNull returns true if this Float is null.
+func (f Float) IsNull() bool {
+	return !f.Valid
+}
+
+// Null is the same as IsNull.
+func (f Float) Null() bool {
+	return !f.Valid
+}
+
+// IsValid returns true if this Float is not null.
+func (f Float) IsValid() bool {
+	return f.Valid
+}
+
 // IsZero returns true for null or zero Floats, for future omitempty support (Go 1.4?)
 func (f Float) IsZero() bool {
 	return !f.Valid || f.Float64 == 0
@@ -136,6 +136,13 @@ func TestFloatIsZero(t *testing.T) {
 	if !zero.IsZero() {
 		t.Errorf("IsZero() should be true")
 	}
+
+	if !NewFloat(0, true).IsValid() {
+		t.Errorf("IsValid() should be true for a zero value")
+	}
+	if NewFloat(0, false).IsValid() {
+		t.Errorf("IsValid() should be false for null")
+	}
 }
 
 func TestFloatSetValid(t *testing.T) {
@@ -157,6 +164,62 @@ func TestFloatScan(t *testing.T) {
 	assertNullFloat(t, null, "scanned null")
 }
 
+func TestFloatFromString(t *testing.T) {
+	f, err := FloatFromString("1.2345")
+	maybePanic(err)
+	assertFloat(t, f, "FloatFromString()")
+
+	for _, s := range []string{"", "0"} {
+		null, err := FloatFromString(s)
+		maybePanic(err)
+		assertNullFloat(t, null, "FloatFromString("+s+")")
+	}
+
+	if _, err := FloatFromString("abc"); err == nil {
+		t.Error("expected error for non-numeric input")
+	}
+}
+
+func TestFloatValueOrZero(t *testing.T) {
+	valid := FloatFrom(1.2345)
+	if valid.ValueOrZero() != 1.2345 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewFloat(1.2345, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestFloatEqual(t *testing.T) {
+	if !FloatFrom(1.5).Equal(FloatFrom(1.5)) {
+		t.Error("equal floats should be Equal")
+	}
+	if FloatFrom(1.5).Equal(FloatFrom(2.5)) {
+		t.Error("different floats should not be Equal")
+	}
+	if !NewFloat(0, false).Equal(NewFloat(0, true)) {
+		t.Error("null and zero floats should be Equal")
+	}
+}
+
+func TestFloatSetNull(t *testing.T) {
+	f := FloatFrom(1.2345)
+	f.SetNull()
+	assertNullFloat(t, f, "SetNull()")
+	if f.Float64 != 0 || !f.IsNull() {
+		t.Errorf("SetNull() left %#v", f)
+	}
+}
+
+func TestUnmarshalFloatObjectInvalid(t *testing.T) {
+	var f Float
+	err := json.Unmarshal([]byte(`{"Float64":1.2345,"Valid":false}`), &f)
+	maybePanic(err)
+	assertNullFloat(t, f, "sql.NullFloat64 json with Valid false")
+}
+
 func assertFloat(t *testing.T, f Float, from string) {
 	if f.Float64 != 1.2345 {
 		t.Errorf("bad %s float: %f ≠ %f\n", from, f.Float64, 1.2345)
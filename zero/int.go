@@ -39,10 +39,20 @@ func IntFromPtr(i *int64) Int {
 	return n
 }
 
+// IntFromString creates a new Int by parsing s as a base 10 integer.
+// It will be null if s is blank or "0", and an error is returned if s is not an integer.
+func IntFromString(s string) (Int, error) {
+	if s == "" {
+		return NewInt(0, false), nil
+	}
+	i, err := strconv.ParseInt(s, 10, 64)
+	return NewInt(i, err == nil && i != 0), err
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Int.
-// It also supports unmarshalling a sql.NullInt64.
+// It also supports unmarshalling a sql.NullInt64, which is null unless its Valid field is true.
 func (i *Int) UnmarshalJSON(data []byte) error {
 	var err error
 	var v interface{}
@@ -51,7 +61,9 @@ func (i *Int) UnmarshalJSON(data []byte) error {
 	case float64:
 		// Unmarshal again, directly to int64, to avoid intermediate float64
 		err = json.Unmarshal(data, &i.Int64)
+		i.Valid = true
 	case map[string]interface{}:
+		i.NullInt64 = sql.NullInt64{}
 		err = json.Unmarshal(data, &i.NullInt64)
 	case nil:
 		i.Valid = false
@@ -59,7 +71,7 @@ func (i *Int) UnmarshalJSON(data []byte) error {
 	default:
 		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type zero.Int", reflect.TypeOf(v).Name())
 	}
-	i.Valid = (err == nil) && (i.Int64 != 0)
+	i.Valid = (err == nil) && i.Valid && (i.Int64 != 0)
 	return err
 }
 
@@ -112,6 +124,46 @@ func (i Int) Ptr() *int64 {
 	return &i.Int64
 }
 
+// SetNull sets this Int to null, resetting its value to zero.
+func (i *Int) SetNull() {
+	i.Int64 = 0
+	i.Valid = false
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int) ValueOrZero() int64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int64
+}
+
+// Equal returns true if both ints have the same value or are both either null or zero.
+func (i Int) Equal(other Int) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// Changed returns true if this Int and old are not Equal.
+// Going from null to zero, or back, is not a change.
+func (i Int) Changed(old Int) bool {
+	return !i.Equal(old)
+}
+
+// IsNull returns true if this Int is null.
+func (i Int) IsNull() bool {
+	return !i.Valid
+}
+
+// Null is the same as IsNull.
+func (i Int) Null() bool {
+	return !i.Valid
+}
+
+// IsValid returns true if this Int is not null.
+func (i Int) IsValid() bool {
+	return i.Valid
+}
+
 // IsZero returns true for null or zero Ints, for future omitempty support (Go 1.4?)
 func (i Int) IsZero() bool {
 	return !i.Valid || i.Int64 == 0
@@ -163,6 +163,13 @@ func TestIntIsZero(t *testing.T) {
 	if !zero.IsZero() {
 		t.Errorf("IsZero() should be true")
 	}
+
+	if !NewInt(0, true).IsValid() {
+		t.Errorf("IsValid() should be true for a zero value")
+	}
+	if NewInt(0, false).IsValid() {
+		t.Errorf("IsValid() should be false for null")
+	}
 }
 
 func TestIntScan(t *testing.T) {
@@ -184,6 +191,65 @@ func TestIntSetValid(t *testing.T) {
 	assertInt(t, change, "SetValid()")
 }
 
+func TestIntFromString(t *testing.T) {
+	i, err := IntFromString("12345")
+	maybePanic(err)
+	assertInt(t, i, "IntFromString()")
+
+	for _, s := range []string{"", "0"} {
+		null, err := IntFromString(s)
+		maybePanic(err)
+		assertNullInt(t, null, "IntFromString("+strconv.Quote(s)+")")
+	}
+
+	if _, err := IntFromString("1.5"); err == nil {
+		t.Error("expected error for non-integer input")
+	}
+}
+
+func TestIntValueOrZero(t *testing.T) {
+	valid := IntFrom(12345)
+	if valid.ValueOrZero() != 12345 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewInt(12345, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestIntEqual(t *testing.T) {
+	if !IntFrom(10).Equal(IntFrom(10)) {
+		t.Error("equal ints should be Equal")
+	}
+	if IntFrom(10).Equal(IntFrom(11)) {
+		t.Error("different ints should not be Equal")
+	}
+	if !NewInt(0, false).Equal(NewInt(0, true)) {
+		t.Error("null and zero ints should be Equal")
+	}
+	if !NewInt(10, false).Equal(NewInt(0, false)) {
+		t.Error("null ints should be Equal regardless of their value")
+	}
+}
+
+func TestIntSetNull(t *testing.T) {
+	i := IntFrom(12345)
+	i.SetNull()
+	assertNullInt(t, i, "SetNull()")
+	if i.Int64 != 0 || !i.IsNull() {
+		t.Errorf("SetNull() left %#v", i)
+	}
+}
+
+func TestUnmarshalIntObjectInvalid(t *testing.T) {
+	var i Int
+	err := json.Unmarshal([]byte(`{"Int64":12345,"Valid":false}`), &i)
+	maybePanic(err)
+	assertNullInt(t, i, "sql.NullInt64 json with Valid false")
+}
+
 func assertInt(t *testing.T, i Int, from string) {
 	if i.Int64 != 12345 {
 		t.Errorf("bad %s int: %d ≠ %d\n", from, i.Int64, 12345)
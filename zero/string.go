@@ -44,7 +44,7 @@ func StringFromPtr(s *string) String {
 
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports string and null input. Blank string input produces a null String.
-// It also supports unmarshalling a sql.NullString.
+// It also supports unmarshalling a sql.NullString, which is null unless its Valid field is true.
 func (s *String) UnmarshalJSON(data []byte) error {
 	var err error
 	var v interface{}
@@ -52,7 +52,9 @@ func (s *String) UnmarshalJSON(data []byte) error {
 	switch x := v.(type) {
 	case string:
 		s.String = x
+		s.Valid = true
 	case map[string]interface{}:
+		s.NullString = sql.NullString{}
 		err = json.Unmarshal(data, &s.NullString)
 	case nil:
 		s.Valid = false
@@ -60,7 +62,7 @@ func (s *String) UnmarshalJSON(data []byte) error {
 	default:
 		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type zero.String", reflect.TypeOf(v).Name())
 	}
-	s.Valid = (err == nil) && (s.String != "")
+	s.Valid = (err == nil) && s.Valid && (s.String != "")
 	return err
 }
 
@@ -95,6 +97,46 @@ func (s String) Ptr() *string {
 	return &s.String
 }
 
+// SetNull sets this String to null, resetting its value to blank.
+func (s *String) SetNull() {
+	s.String = ""
+	s.Valid = false
+}
+
+// ValueOrZero returns the inner value if valid, otherwise a blank string.
+func (s String) ValueOrZero() string {
+	if !s.Valid {
+		return ""
+	}
+	return s.String
+}
+
+// Equal returns true if both strings have the same value or are both either null or blank.
+func (s String) Equal(other String) bool {
+	return s.ValueOrZero() == other.ValueOrZero()
+}
+
+// Changed returns true if this String and old are not Equal.
+// Going from null to a blank string, or back, is not a change.
+func (s String) Changed(old String) bool {
+	return !s.Equal(old)
+}
+
+// IsNull returns true if this String is null.
+func (s String) IsNull() bool {
+	return !s.Valid
+}
+
+// Null is the same as IsNull.
+func (s String) Null() bool {
+	return !s.Valid
+}
+
+// IsValid returns true if this String is not null.
+func (s String) IsValid() bool {
+	return s.Valid
+}
+
 // IsZero returns true for null or empty strings, for future omitempty support. (Go 1.4?)
 func (s String) IsZero() bool {
 	return !s.Valid || s.String == ""
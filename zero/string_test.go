@@ -1,8 +1,12 @@
 package zero
 
 import (
+	"encoding"
 	"encoding/json"
+	"strings"
 	"testing"
+
+	"github.com/guregu/null"
 )
 
 var (
@@ -53,6 +57,20 @@ func TestUnmarshalString(t *testing.T) {
 	assertNullStr(t, badType, "wrong type json")
 }
 
+func TestUnmarshalBlankJSON(t *testing.T) {
+	for _, data := range [][]byte{{}, []byte(" \n\t")} {
+		s, i, f, b := StringFrom("test"), IntFrom(12345), FloatFrom(1.2345), BoolFrom(true)
+		for _, u := range []json.Unmarshaler{&s, &i, &f, &b} {
+			if err := u.UnmarshalJSON(data); err != nil {
+				t.Errorf("%T: unexpected error for %q: %v", u, data, err)
+			}
+		}
+		if s.Valid || i.Valid || f.Valid || b.Valid {
+			t.Errorf("%q should decode to null: %v %v %v %v", data, s, i, f, b)
+		}
+	}
+}
+
 func TestTextUnmarshalString(t *testing.T) {
 	var str String
 	err := str.UnmarshalText([]byte("test"))
@@ -131,6 +149,13 @@ func TestStringIsZero(t *testing.T) {
 	if !empty.IsZero() {
 		t.Errorf("IsZero() should be true")
 	}
+
+	if !NewString("", true).IsValid() {
+		t.Errorf("IsValid() should be true for a zero value")
+	}
+	if NewString("", false).IsValid() {
+		t.Errorf("IsValid() should be false for null")
+	}
 }
 
 func TestStringScan(t *testing.T) {
@@ -152,6 +177,256 @@ func TestStringSetValid(t *testing.T) {
 	assertStr(t, change, "SetValid()")
 }
 
+func TestStringValueOrZero(t *testing.T) {
+	valid := StringFrom("test")
+	if valid.ValueOrZero() != "test" {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewString("test", false)
+	if invalid.ValueOrZero() != "" {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestStringEqual(t *testing.T) {
+	if !StringFrom("test").Equal(StringFrom("test")) {
+		t.Error("equal strings should be Equal")
+	}
+	if StringFrom("test").Equal(StringFrom("other")) {
+		t.Error("different strings should not be Equal")
+	}
+	if !NewString("", false).Equal(NewString("", true)) {
+		t.Error("null and blank strings should be Equal")
+	}
+	if !NewString("stale", false).Equal(NewString("", false)) {
+		t.Error("null strings should be Equal regardless of their value")
+	}
+}
+
+func TestStringSetNull(t *testing.T) {
+	s := StringFrom("test")
+	s.SetNull()
+	assertNullStr(t, s, "SetNull()")
+	if s.String != "" || !s.IsNull() {
+		t.Errorf("SetNull() left %#v", s)
+	}
+}
+
+func TestUnmarshalStringObjectInvalid(t *testing.T) {
+	var s String
+	err := json.Unmarshal([]byte(`{"String":"test","Valid":false}`), &s)
+	maybePanic(err)
+	assertNullStr(t, s, "sql.NullString json with Valid false")
+}
+
+func TestStringChanged(t *testing.T) {
+	if NewString("", false).Changed(NewString("", false)) {
+		t.Error("null → null should not be a change")
+	}
+	if !StringFrom("test").Changed(NewString("", false)) {
+		t.Error("null → value should be a change")
+	}
+	if StringFrom("test").Changed(StringFrom("test")) {
+		t.Error("value → same value should not be a change")
+	}
+	if !StringFrom("other").Changed(StringFrom("test")) {
+		t.Error("value → different value should be a change")
+	}
+	if !NewString("", false).Changed(StringFrom("test")) {
+		t.Error("value → null should be a change")
+	}
+	if NewString("", true).Changed(NewString("", false)) {
+		t.Error("null → blank should not be a change")
+	}
+}
+
+// parityValue is implemented by pointers to the types in both this package and null.
+type parityValue interface {
+	json.Unmarshaler
+	encoding.TextUnmarshaler
+	IsValid() bool
+}
+
+func TestMarshalJSONNeverEmpty(t *testing.T) {
+	values := []interface{}{
+		String{}, Int{}, Float{}, Bool{}, Time{},
+		StringFrom("test"), IntFrom(1), FloatFrom(1.5), BoolFrom(true), TimeFrom(timeValue),
+	}
+	for _, v := range values {
+		out, err := json.Marshal(v)
+		maybePanic(err)
+		if len(out) == 0 || !json.Valid(out) {
+			t.Errorf("json.Marshal(%T) = %q, want valid JSON", v, out)
+		}
+	}
+}
+
+func TestNullMethod(t *testing.T) {
+	for _, tc := range []struct {
+		v interface {
+			Null() bool
+			IsNull() bool
+		}
+		null bool
+	}{
+		{StringFrom("test"), false}, {String{}, true},
+		{IntFrom(1), false}, {Int{}, true},
+		{FloatFrom(1.5), false}, {Float{}, true},
+		{BoolFrom(true), false}, {Bool{}, true},
+		{TimeFrom(timeValue), false}, {Time{}, true},
+	} {
+		if tc.v.Null() != tc.null || tc.v.Null() != tc.v.IsNull() {
+			t.Errorf("%T.Null() = %v, want %v", tc.v, tc.v.Null(), tc.null)
+		}
+	}
+}
+
+// TestNullParity decodes the same input into each type here and its null counterpart.
+// Both packages must agree on null and non-zero input. Zero input (blank, 0, false,
+// or the zero time) is always null here, but only null.Int, null.Float and null.Bool
+// keep it valid: null.String and null.Time already treat blank and the zero time as null.
+func TestNullParity(t *testing.T) {
+	types := []struct {
+		name          string
+		zero, null    func() parityValue
+		value, empty  string
+		object, blank string
+		more          []string // other input both packages must decode as valid
+		nullEmpty     bool     // whether null keeps empty input valid
+	}{
+		{
+			name: "String",
+			zero: func() parityValue { return new(String) }, null: func() parityValue { return new(null.String) },
+			value: `"test"`, empty: `""`,
+			object: `{"String":"test","Valid":false}`, blank: `{"String":"","Valid":true}`,
+		},
+		{
+			name: "Int", nullEmpty: true,
+			zero: func() parityValue { return new(Int) }, null: func() parityValue { return new(null.Int) },
+			value: `12345`, empty: `0`,
+			object: `{"Int64":12345,"Valid":false}`, blank: `{"Int64":0,"Valid":true}`,
+		},
+		{
+			name: "Float", nullEmpty: true,
+			zero: func() parityValue { return new(Float) }, null: func() parityValue { return new(null.Float) },
+			value: `1.2345`, empty: `0`,
+			object: `{"Float64":1.2345,"Valid":false}`, blank: `{"Float64":0,"Valid":true}`,
+		},
+		{
+			name: "Bool", nullEmpty: true,
+			zero: func() parityValue { return new(Bool) }, null: func() parityValue { return new(null.Bool) },
+			value: `true`, empty: `false`,
+			object: `{"Bool":true,"Valid":false}`, blank: `{"Bool":false,"Valid":true}`,
+		},
+		{
+			name: "Time",
+			zero: func() parityValue { return new(Time) }, null: func() parityValue { return new(null.Time) },
+			value: `"2012-12-21T21:21:21Z"`, empty: `"0001-01-01T00:00:00Z"`,
+			object: `{"Time":"2012-12-21T21:21:21Z","Valid":false}`, blank: `{"Time":"0001-01-01T00:00:00Z","Valid":true}`,
+			more: []string{`1356124881`, `"2012-12-21 21:21:21"`, `"2012-12-21"`, `"infinity"`},
+		},
+	}
+	for _, typ := range types {
+		cases := []struct {
+			input      string
+			zero, null bool
+		}{
+			{typ.value, true, true},
+			{`null`, false, false},
+			{typ.object, false, false},
+			{typ.empty, false, typ.nullEmpty},
+			{typ.blank, false, typ.nullEmpty},
+		}
+		for _, in := range typ.more {
+			cases = append(cases, struct {
+				input      string
+				zero, null bool
+			}{in, true, true})
+		}
+		for _, c := range cases {
+			z, n := typ.zero(), typ.null()
+			if err := z.UnmarshalJSON([]byte(c.input)); err != nil {
+				t.Errorf("zero.%s: UnmarshalJSON(%s): %v", typ.name, c.input, err)
+			}
+			if err := n.UnmarshalJSON([]byte(c.input)); err != nil {
+				t.Errorf("null.%s: UnmarshalJSON(%s): %v", typ.name, c.input, err)
+			}
+			if z.IsValid() != c.zero {
+				t.Errorf("zero.%s: UnmarshalJSON(%s) valid = %v, want %v", typ.name, c.input, z.IsValid(), c.zero)
+			}
+			if n.IsValid() != c.null {
+				t.Errorf("null.%s: UnmarshalJSON(%s) valid = %v, want %v", typ.name, c.input, n.IsValid(), c.null)
+			}
+		}
+
+		// text input is the JSON input unquoted, and blank text is null in both packages
+		text := strings.Trim(typ.empty, `"`)
+		z, n := typ.zero(), typ.null()
+		if err := z.UnmarshalText([]byte(text)); err != nil || z.IsValid() {
+			t.Errorf("zero.%s: UnmarshalText(%q) valid = %v, err = %v; want null", typ.name, text, z.IsValid(), err)
+		}
+		if err := n.UnmarshalText([]byte(text)); err != nil || (text != "" && !n.IsValid()) {
+			t.Errorf("null.%s: UnmarshalText(%q) valid = %v, err = %v; want valid", typ.name, text, n.IsValid(), err)
+		}
+		if typ.name != "Bool" {
+			z, n = typ.zero(), typ.null()
+			z.UnmarshalText(nil)
+			n.UnmarshalText(nil)
+			if z.IsValid() || n.IsValid() {
+				t.Errorf("%s: blank text should be null in both packages", typ.name)
+			}
+		}
+	}
+}
+
+// TestTimeParity checks that zero.Time follows the package-wide null.Time settings,
+// decoding to the same instant as null.Time and rejecting the same input.
+func TestTimeParity(t *testing.T) {
+	defer func(milli bool, formats []string) { null.UnixMilli, null.TimeFormats = milli, formats }(null.UnixMilli, null.TimeFormats)
+	inputs := []string{`1356124881000`, `"21/12/2012"`, `"2012-12-21T21:21:21Z"`, `1e30`, `1.5`, `"2012-12-21 21:21:21"`}
+	for _, milli := range []bool{false, true} {
+		null.UnixMilli = milli
+		null.TimeFormats = []string{"02/01/2006"}
+		for _, in := range inputs {
+			var z Time
+			var n null.Time
+			zerr, nerr := z.UnmarshalJSON([]byte(in)), n.UnmarshalJSON([]byte(in))
+			if (zerr == nil) != (nerr == nil) || z.Valid != n.Valid || !z.Time.Equal(n.Time) {
+				t.Errorf("UnmarshalJSON(%s) with UnixMilli %v: zero.Time = %v, %v (err: %v); null.Time = %v, %v (err: %v)",
+					in, milli, z.Time, z.Valid, zerr, n.Time, n.Valid, nerr)
+			}
+
+			text := strings.Trim(in, `"`)
+			z, n = Time{}, null.Time{}
+			zerr, nerr = z.UnmarshalText([]byte(text)), n.UnmarshalText([]byte(text))
+			if (zerr == nil) != (nerr == nil) || z.Valid != n.Valid || !z.Time.Equal(n.Time) {
+				t.Errorf("UnmarshalText(%q): zero.Time = %v, %v (err: %v); null.Time = %v, %v (err: %v)",
+					text, z.Time, z.Valid, zerr, n.Time, n.Valid, nerr)
+			}
+
+			z, n = Time{}, null.Time{}
+			zerr, nerr = z.Scan(text), n.Scan(text)
+			if (zerr == nil) != (nerr == nil) || z.Valid != n.Valid || !z.Time.Equal(n.Time) {
+				t.Errorf("Scan(%q): zero.Time = %v, %v (err: %v); null.Time = %v, %v (err: %v)",
+					text, z.Time, z.Valid, zerr, n.Time, n.Valid, nerr)
+			}
+		}
+	}
+
+	defer null.SetTimeFormat(null.TimeFormat)
+	null.SetTimeFormat("2006-01-02 15:04:05")
+	ti := TimeFrom(timeValue)
+	for _, m := range []interface{ MarshalText() ([]byte, error) }{ti, null.TimeFrom(timeValue)} {
+		data, err := m.MarshalText()
+		maybePanic(err)
+		assertJSONEquals(t, data, "2012-12-21 21:21:21", "custom TimeFormat text marshal")
+	}
+	data, err := json.Marshal(ti)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21 21:21:21"`, "custom TimeFormat json marshal")
+}
+
 func maybePanic(err error) {
 	if err != nil {
 		panic(err)
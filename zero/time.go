@@ -0,0 +1,174 @@
+package zero
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/guregu/null"
+)
+
+// Time is a nullable time.Time.
+// JSON marshals to the zero time if null.
+// Considered null to SQL if zero.
+type Time struct {
+	sql.NullTime
+}
+
+// NewTime creates a new Time
+func NewTime(t time.Time, valid bool) Time {
+	return Time{
+		NullTime: sql.NullTime{
+			Time:  t,
+			Valid: valid,
+		},
+	}
+}
+
+// TimeFrom creates a new Time that will be null if t is the zero time.
+func TimeFrom(t time.Time) Time {
+	return NewTime(t, !t.IsZero())
+}
+
+// TimeFromPtr creates a new Time that will be null if t is nil or the zero time.
+func TimeFromPtr(t *time.Time) Time {
+	if t == nil {
+		return NewTime(time.Time{}, false)
+	}
+	return TimeFrom(*t)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It decodes the same input as null.Time: strings in null.TimeFormat or any of null.TimeFormats,
+// Unix timestamp numbers (in milliseconds if null.UnixMilli is set), and null input.
+// Blank string input and the zero time produce a null Time.
+// It also supports unmarshalling a sql.NullTime, which is null unless its Valid field is true.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var nt null.Time
+	err := nt.UnmarshalJSON(data)
+	t.set(nt, err)
+	return zeroTimeError(err)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode the zero time if this Time is null, and otherwise encodes like null.Time,
+// in null.TimeFormat.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return null.TimeFrom(t.ValueOrZero()).MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode the zero time if this Time is null, and otherwise encodes like null.Time,
+// in null.TimeFormat.
+func (t Time) MarshalText() ([]byte, error) {
+	return null.TimeFrom(t.ValueOrZero()).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It decodes the same input as null.Time's UnmarshalText.
+// It will unmarshal to a null Time if the input is blank, "null", or the zero time.
+func (t *Time) UnmarshalText(text []byte) error {
+	var nt null.Time
+	err := nt.UnmarshalText(text)
+	t.set(nt, err)
+	return err
+}
+
+// Scan implements sql.Scanner.
+// It scans the same values as null.Time, including timestamp strings in any of null.TimeFormats.
+// The zero time is scanned as a null Time.
+func (t *Time) Scan(value interface{}) error {
+	var nt null.Time
+	err := nt.Scan(value)
+	t.set(nt, err)
+	return err
+}
+
+// set copies the result of decoding into nt, treating the zero time and errors as null.
+func (t *Time) set(nt null.Time, err error) {
+	if err != nil || !nt.Valid || nt.Time.IsZero() {
+		t.Time, t.Valid = time.Time{}, false
+		return
+	}
+	t.Time, t.Valid = nt.Time, true
+}
+
+// zeroTimeError reports JSON decoding errors as coming from zero.Time rather than the null.Time it decodes with.
+func zeroTimeError(err error) error {
+	var ue *null.UnmarshalError
+	if errors.As(err, &ue) && ue.Type == "null.Time" {
+		copied := *ue
+		copied.Type = "zero.Time"
+		return &copied
+	}
+	return err
+}
+
+// Value implements driver.Valuer.
+// It returns nil if this Time is null or the zero time.
+func (t Time) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// SetValid changes this Time's value and also sets it to be non-null.
+func (t *Time) SetValid(v time.Time) {
+	t.Time = v
+	t.Valid = true
+}
+
+// SetNull sets this Time to null, resetting its value to the zero time.
+func (t *Time) SetNull() {
+	t.Time = time.Time{}
+	t.Valid = false
+}
+
+// Ptr returns a pointer to this Time's value, or a nil pointer if this Time is null.
+func (t Time) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the zero time.
+func (t Time) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
+// Equal returns true if both Times represent the same instant or are both either null or the zero time.
+func (t Time) Equal(other Time) bool {
+	return t.ValueOrZero().Equal(other.ValueOrZero())
+}
+
+// Changed returns true if this Time and old are not Equal.
+// Going from null to the zero time, or back, is not a change.
+func (t Time) Changed(old Time) bool {
+	return !t.Equal(old)
+}
+
+// IsNull returns true if this Time is null.
+func (t Time) IsNull() bool {
+	return !t.Valid
+}
+
+// Null is the same as IsNull.
+func (t Time) Null() bool {
+	return !t.Valid
+}
+
+// IsValid returns true if this Time is not null.
+func (t Time) IsValid() bool {
+	return t.Valid
+}
+
+// IsZero returns true for null Times and for valid ones holding the zero time, for omitempty support.
+func (t Time) IsZero() bool {
+	return !t.Valid || t.Time.IsZero()
+}
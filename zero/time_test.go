@@ -0,0 +1,263 @@
+package zero
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+)
+
+var (
+	timeString    = "2012-12-21T21:21:21Z"
+	timeJSON      = []byte(`"` + timeString + `"`)
+	zeroTimeStr   = "0001-01-01T00:00:00Z"
+	zeroTimeJSON  = []byte(`"` + zeroTimeStr + `"`)
+	nullTimeJSON  = []byte(`{"Time":"2012-12-21T21:21:21Z","Valid":true}`)
+	timeValue, _  = time.Parse(time.RFC3339, timeString)
+	badObjectJSON = []byte(`{"hello": "world"}`)
+)
+
+func TestTimeFrom(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	assertTime(t, ti, "TimeFrom() time.Time")
+
+	zero := TimeFrom(time.Time{})
+	assertNullTime(t, zero, "TimeFrom() zero time")
+}
+
+func TestTimeFromPtr(t *testing.T) {
+	ti := TimeFromPtr(&timeValue)
+	assertTime(t, ti, "TimeFromPtr() time")
+
+	null := TimeFromPtr(nil)
+	assertNullTime(t, null, "TimeFromPtr(nil)")
+}
+
+func TestUnmarshalTimeJSON(t *testing.T) {
+	var ti Time
+	err := json.Unmarshal(timeJSON, &ti)
+	maybePanic(err)
+	assertTime(t, ti, "UnmarshalJSON() json")
+
+	var nt Time
+	err = json.Unmarshal(nullTimeJSON, &nt)
+	maybePanic(err)
+	assertTime(t, nt, "sql.NullTime json")
+
+	var invalidObj Time
+	err = json.Unmarshal([]byte(`{"Time":"2012-12-21T21:21:21Z","Valid":false}`), &invalidObj)
+	maybePanic(err)
+	assertNullTime(t, invalidObj, "sql.NullTime json with Valid false")
+
+	var blank Time
+	err = json.Unmarshal(blankStringJSON, &blank)
+	maybePanic(err)
+	assertNullTime(t, blank, "blank string json")
+
+	var zero Time
+	err = json.Unmarshal(zeroTimeJSON, &zero)
+	maybePanic(err)
+	assertNullTime(t, zero, "zero time json")
+
+	var nullTime Time
+	err = json.Unmarshal(nullJSON, &nullTime)
+	maybePanic(err)
+	assertNullTime(t, nullTime, "null time json")
+
+	var invalid Time
+	err = json.Unmarshal([]byte(`"hello world"`), &invalid)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, invalid, "invalid string json")
+
+	var bad Time
+	err = json.Unmarshal(badObjectJSON, &bad)
+	maybePanic(err)
+	assertNullTime(t, bad, "bad object json")
+
+	var unix Time
+	err = json.Unmarshal([]byte(`1356124881`), &unix)
+	maybePanic(err)
+	assertTime(t, unix, "unix timestamp json")
+
+	var epoch Time
+	err = json.Unmarshal([]byte(`0`), &epoch)
+	maybePanic(err)
+	if !epoch.Valid || !epoch.Time.Equal(time.Unix(0, 0)) {
+		t.Errorf("bad unix epoch json: %v (valid: %t)", epoch.Time, epoch.Valid)
+	}
+
+	var wrongType Time
+	err = json.Unmarshal([]byte(`true`), &wrongType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	var ue *null.UnmarshalError
+	if !errors.As(err, &ue) || ue.Type != "zero.Time" {
+		t.Errorf("error should be an UnmarshalError for zero.Time: %v", err)
+	}
+	assertNullTime(t, wrongType, "wrong type json")
+
+	var fractional Time
+	err = json.Unmarshal([]byte(`1.5`), &fractional)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, fractional, "fractional unix timestamp json")
+}
+
+func TestUnmarshalTimeText(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	txt, err := ti.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, timeString, "marshal text")
+
+	var unmarshal Time
+	err = unmarshal.UnmarshalText(txt)
+	maybePanic(err)
+	assertTime(t, unmarshal, "unmarshal text")
+
+	for _, s := range []string{"", "null", zeroTimeStr} {
+		var null Time
+		err = null.UnmarshalText([]byte(s))
+		maybePanic(err)
+		assertNullTime(t, null, "unmarshal text "+s)
+	}
+
+	var invalid Time
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, invalid, "bad string")
+}
+
+func TestMarshalTime(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	data, err := json.Marshal(ti)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(timeJSON), "non-empty json marshal")
+
+	null := TimeFromPtr(nil)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(zeroTimeJSON), "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, zeroTimeStr, "null text marshal")
+}
+
+func TestTimeScanValue(t *testing.T) {
+	var ti Time
+	err := ti.Scan(timeValue)
+	maybePanic(err)
+	assertTime(t, ti, "scanned time")
+	if v, err := ti.Value(); v != timeValue || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var zero Time
+	err = zero.Scan(time.Time{})
+	maybePanic(err)
+	assertNullTime(t, zero, "scanned zero time")
+
+	var null Time
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullTime(t, null, "scanned null")
+	if v, err := NewTime(time.Time{}, true).Value(); v != nil || err != nil {
+		t.Error("bad value or err for valid zero time:", v, err)
+	}
+
+	var wrong Time
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, wrong, "scanned int64")
+}
+
+func TestTimeValueOrZero(t *testing.T) {
+	valid := TimeFrom(timeValue)
+	if valid.ValueOrZero() != timeValue {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewTime(timeValue, false)
+	if !invalid.ValueOrZero().IsZero() {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestTimeEqual(t *testing.T) {
+	if !TimeFrom(timeValue).Equal(TimeFrom(timeValue.In(time.FixedZone("test", 3600)))) {
+		t.Error("the same instant in different locations should be Equal")
+	}
+	if TimeFrom(timeValue).Equal(TimeFrom(timeValue.Add(time.Second))) {
+		t.Error("different instants should not be Equal")
+	}
+	if !NewTime(time.Time{}, false).Equal(NewTime(time.Time{}, true)) {
+		t.Error("null and zero Times should be Equal")
+	}
+	if !NewTime(timeValue, false).Equal(NewTime(time.Time{}, false)) {
+		t.Error("null Times should be Equal regardless of their value")
+	}
+}
+
+func TestTimeIsZero(t *testing.T) {
+	if TimeFrom(timeValue).IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+	if !NewTime(time.Time{}, true).IsZero() {
+		t.Errorf("IsZero() should be true for a valid zero time")
+	}
+	if !TimeFromPtr(nil).IsZero() {
+		t.Errorf("IsZero() should be true for null")
+	}
+}
+
+func TestTimePointer(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	ptr := ti.Ptr()
+	if *ptr != timeValue {
+		t.Errorf("bad %s time: %#v ≠ %v\n", "pointer", ptr, timeValue)
+	}
+
+	var null Time
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s time: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestTimeSetValidNull(t *testing.T) {
+	var ti Time
+	assertNullTime(t, ti, "SetValid()")
+	ti.SetValid(timeValue)
+	assertTime(t, ti, "SetValid()")
+
+	ti.SetNull()
+	assertNullTime(t, ti, "SetNull()")
+	if !ti.Time.IsZero() || !ti.IsNull() {
+		t.Errorf("SetNull() left %#v", ti)
+	}
+}
+
+func assertTime(t *testing.T, ti Time, from string) {
+	if !ti.Time.Equal(timeValue) {
+		t.Errorf("bad %v time: %v ≠ %v\n", from, ti.Time, timeValue)
+	}
+	if !ti.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullTime(t *testing.T, ti Time, from string) {
+	if ti.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}